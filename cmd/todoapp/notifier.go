@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Reminder is what a Notifier delivers.
+type Reminder struct {
+	UserID   string     `json:"user_id"`
+	TodoID   string     `json:"todo_id"`
+	Todo     string     `json:"todo"`
+	RemindAt time.Time  `json:"remind_at"`
+	DueAt    *time.Time `json:"due_at,omitempty"`
+}
+
+// ErrUndeliverable is returned, wrapped, by a Notifier that will never be
+// able to deliver a reminder.
+var ErrUndeliverable = errors.New("reminder cannot be delivered")
+
+// Notifier delivers reminders. A reminder is marked as sent if and only if
+// Notify returns nil, and dropped if it returns ErrUndeliverable; otherwise
+// it is retried.
+type Notifier interface {
+	Notify(ctx context.Context, r Reminder) error
+}
+
+// webhookNotifier POSTs each reminder as JSON to a URL, which must respond
+// with a 2xx status.
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookNotifier(url string) *webhookNotifier {
+	return &webhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, r Reminder) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+
+	return nil
+}
+
+// smtpNotifier emails each reminder. The recipient is to with "{user_id}"
+// replaced by the user's id, so, for example, "{user_id}@example.com" works
+// for user ids that are usernames.
+type smtpNotifier struct {
+	addr string
+	host string
+	auth smtp.Auth
+	from string
+	to   string
+}
+
+// newSMTPNotifier returns a notifier that sends mail through the server at
+// addr, authenticating only if username is not empty.
+func newSMTPNotifier(addr, username, password, from, to string) (*smtpNotifier, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &smtpNotifier{
+		addr: addr,
+		host: host,
+		auth: auth,
+		from: from,
+		to:   to,
+	}, nil
+}
+
+func (n *smtpNotifier) Notify(ctx context.Context, r Reminder) error {
+	// The user id comes from the token, so it must not be able to add
+	// headers or recipients either.
+	to, err := mail.ParseAddress(strings.ReplaceAll(n.to, "{user_id}", r.UserID))
+	if err != nil {
+		return fmt.Errorf("%w: invalid recipient for user %q: %v", ErrUndeliverable, r.UserID, err)
+	}
+
+	// The todo is user input, so it must not be able to add headers.
+	subject := strings.Join(strings.Fields("Reminder: "+r.Todo), " ")
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n")
+	fmt.Fprintf(&msg, "\r\n%s\r\n", r.Todo)
+	if r.DueAt != nil {
+		fmt.Fprintf(&msg, "\r\nDue %s\r\n", r.DueAt.Format(time.RFC1123))
+	}
+
+	return n.send(ctx, to.Address, msg.Bytes())
+}
+
+// send is smtp.SendMail, except that it gives up when ctx is done, so that a
+// hung server cannot hold up the scheduler.
+func (n *smtpNotifier) send(ctx context.Context, to string, msg []byte) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	// Closing the connection unblocks any read or write in progress.
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+
+	c, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return err
+		}
+	}
+
+	if n.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+
+		if err := c.Auth(n.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(n.from); err != nil {
+		return err
+	}
+
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
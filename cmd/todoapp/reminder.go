@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// reminderBatchSize is how many reminders are claimed at a time.
+	reminderBatchSize = 100
+
+	// reminderNotifyTimeout is the deadline Notify gets for one reminder,
+	// so that one slow notification does not hold up the rest of the batch.
+	reminderNotifyTimeout = 10 * time.Second
+
+	// reminderClaimTimeout is how long a claimed reminder waits to be sent
+	// before another scheduler can claim it. It is longer than sending a
+	// whole batch can take.
+	reminderClaimTimeout = 2 * reminderBatchSize * reminderNotifyTimeout
+)
+
+// newNotifier returns the notifier that cfg.ReminderNotifier names, or nil if
+// it is empty.
+func newNotifier(cfg *config) (Notifier, error) {
+	switch cfg.ReminderNotifier {
+	case "":
+		return nil, nil
+	case "webhook":
+		return newWebhookNotifier(cfg.ReminderWebhookURL), nil
+	case "smtp":
+		return newSMTPNotifier(cfg.ReminderSMTPAddr, cfg.ReminderSMTPUsername, cfg.ReminderSMTPPassword, cfg.ReminderSMTPFrom, cfg.ReminderSMTPTo)
+	default:
+		return nil, fmt.Errorf("unknown reminder notifier %q", cfg.ReminderNotifier)
+	}
+}
+
+// runReminderScheduler sends due reminders every interval until ctx is
+// cancelled.
+func runReminderScheduler(ctx context.Context, pool *pgxpool.Pool, notifier Notifier, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				n, err := sendReminders(ctx, pool, notifier)
+				if err != nil {
+					slog.ErrorContext(ctx, "error sending reminders", "error", err.Error())
+					break
+				}
+
+				if n > 0 {
+					slog.InfoContext(ctx, "sent reminders", "count", n)
+				}
+
+				// Only a full batch, all of it sent, suggests that more are
+				// due. Failed reminders wait for the next tick.
+				if n < reminderBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// sendReminders claims a batch of due reminders, sends them, and returns how
+// many were sent. Claiming is a single short statement, and other schedulers
+// skip claimed reminders, so no locks are held while sending and replicas do
+// not send the same reminder at the same time. A reminder is marked as sent,
+// or released to be retried if it fails to send, on its own. Delivery is at
+// least once: a reminder whose claim expires before it is marked as sent,
+// because its scheduler died or marking it failed, is sent again.
+func sendReminders(ctx context.Context, pool *pgxpool.Pool, notifier Notifier) (int, error) {
+	q := sqlc.New(pool)
+
+	rows, err := q.ClaimDueReminders(ctx, sqlc.ClaimDueRemindersParams{
+		ClaimExpiredBefore: pgtype.Timestamptz{Time: time.Now().Add(-reminderClaimTimeout), Valid: true},
+		Limit:              reminderBatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, row := range rows {
+		r := Reminder{
+			UserID:   row.UserID,
+			TodoID:   row.TodoID,
+			Todo:     row.Todo,
+			RemindAt: row.RemindAt.Time,
+		}
+		if row.DueAt.Valid {
+			r.DueAt = &row.DueAt.Time
+		}
+
+		claim := sqlc.MarkReminderSentParams{
+			UserID:    row.UserID,
+			TodoID:    row.TodoID,
+			ClaimedAt: row.ClaimedAt,
+		}
+
+		notifyCtx, cancel := context.WithTimeout(ctx, reminderNotifyTimeout)
+		err := notifier.Notify(notifyCtx, r)
+		cancel()
+
+		if err != nil && !errors.Is(err, ErrUndeliverable) {
+			slog.ErrorContext(ctx, "error sending reminder", "userID", row.UserID, "todoID", row.TodoID, "error", err.Error())
+
+			if err := q.ReleaseReminder(ctx, sqlc.ReleaseReminderParams(claim)); err != nil {
+				slog.ErrorContext(ctx, "error releasing reminder", "userID", row.UserID, "todoID", row.TodoID, "error", err.Error())
+			}
+
+			continue
+		}
+
+		// Retrying would fail the same way, so the reminder is dropped by
+		// marking it as sent.
+		if err != nil {
+			slog.ErrorContext(ctx, "dropping undeliverable reminder", "userID", row.UserID, "todoID", row.TodoID, "error", err.Error())
+		}
+
+		// If this fails the claim expires and the reminder is sent again,
+		// which is better than not sending it at all.
+		if err := q.MarkReminderSent(ctx, claim); err != nil {
+			return sent, err
+		}
+
+		if err == nil {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
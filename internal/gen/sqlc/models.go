@@ -8,11 +8,140 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type TodoappAssignment struct {
+	ID         int64
+	UserID     string
+	TodoID     string
+	AssigneeID pgtype.Text
+	AssignedBy string
+	CreatedAt  pgtype.Timestamptz
+}
+
+type TodoappChangeSeq struct {
+	UserID string
+	Seq    int64
+}
+
+type TodoappComment struct {
+	ID        int64
+	CommentID string
+	OwnerID   string
+	TodoID    string
+	UserID    string
+	Body      string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type TodoappIdempotencyKey struct {
+	UserID      string
+	Key         string
+	Response    []byte
+	CreatedAt   pgtype.Timestamptz
+	ExpiresAt   pgtype.Timestamptz
+	RequestHash []byte
+}
+
+type TodoappList struct {
+	ID        int64
+	UserID    string
+	ListID    string
+	Name      string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type TodoappListShare struct {
+	OwnerID   string
+	ListID    string
+	UserID    string
+	Role      string
+	CreatedAt pgtype.Timestamptz
+}
+
+type TodoappOperation struct {
+	ID           int64
+	UserID       string
+	TodoID       string
+	Kind         string
+	Version      int64
+	Todo         pgtype.Text
+	DueAt        pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+	ParentTodoID pgtype.Text
+	Rrule        pgtype.Text
+	RemindAt     pgtype.Timestamptz
+	Priority     pgtype.Int2
+}
+
+type TodoappReminder struct {
+	UserID    string
+	TodoID    string
+	RemindAt  pgtype.Timestamptz
+	SentAt    pgtype.Timestamptz
+	ClaimedAt pgtype.Timestamptz
+}
+
+type TodoappTag struct {
+	UserID    string
+	Name      string
+	CreatedAt pgtype.Timestamptz
+}
+
 type TodoappTodo struct {
+	ID                  int64
+	UserID              string
+	TodoID              string
+	Todo                string
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	Completed           bool
+	CompletedAt         pgtype.Timestamptz
+	DueAt               pgtype.Timestamptz
+	ListID              pgtype.Text
+	SearchVector        string
+	Version             int64
+	DeletedAt           pgtype.Timestamptz
+	ChangeSeq           int64
+	ParentTodoID        pgtype.Text
+	ChildCount          int32
+	CompletedChildCount int32
+	Position            string
+	Rrule               pgtype.Text
+	RemindAt            pgtype.Timestamptz
+	Priority            int16
+	AssigneeID          pgtype.Text
+}
+
+type TodoappTodoEvent struct {
 	ID        int64
 	UserID    string
 	TodoID    string
+	Kind      string
+	CreatedAt pgtype.Timestamptz
+	Seq       int64
+}
+
+type TodoappTodoRevision struct {
+	ID        int64
+	UserID    string
+	TodoID    string
+	Version   int64
 	Todo      string
+	DueAt     pgtype.Timestamptz
 	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
+}
+
+type TodoappTodoTag struct {
+	UserID    string
+	TodoID    string
+	Name      string
+	CreatedAt pgtype.Timestamptz
+}
+
+type TodoappTodoTombstone struct {
+	UserID    string
+	TodoID    string
+	ChangeSeq int64
+	DeletedAt pgtype.Timestamptz
 }
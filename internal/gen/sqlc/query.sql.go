@@ -7,21 +7,196 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addComment = `-- name: AddComment :one
+insert into todoapp.comment (owner_id, todo_id, user_id, body)
+values ($1, $2, $3, $4)
+returning id, comment_id, owner_id, todo_id, user_id, body, created_at, updated_at
+`
+
+type AddCommentParams struct {
+	OwnerID string
+	TodoID  string
+	UserID  string
+	Body    string
+}
+
+func (q *Queries) AddComment(ctx context.Context, arg AddCommentParams) (TodoappComment, error) {
+	row := q.db.QueryRow(ctx, addComment, arg.OwnerID, arg.TodoID, arg.UserID, arg.Body)
+	var i TodoappComment
+	err := row.Scan(
+		&i.ID,
+		&i.CommentID,
+		&i.OwnerID,
+		&i.TodoID,
+		&i.UserID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const addTags = `-- name: AddTags :exec
+insert into todoapp.todo_tag (user_id, todo_id, name)
+select $1::text, $2::text, unnest($3::text[])
+on conflict do nothing
+`
+
+type AddTagsParams struct {
+	UserID string
+	TodoID string
+	Names  []string
+}
+
+func (q *Queries) AddTags(ctx context.Context, arg AddTagsParams) error {
+	_, err := q.db.Exec(ctx, addTags, arg.UserID, arg.TodoID, arg.Names)
+	return err
+}
+
+const claimDueReminders = `-- name: ClaimDueReminders :many
+with due as (
+    select r.user_id, r.todo_id
+    from todoapp.reminder r
+    join todoapp.todo t on t.user_id = r.user_id and t.todo_id = r.todo_id
+    where r.sent_at is null and r.remind_at <= now()
+    and (r.claimed_at is null or r.claimed_at < $1::timestamptz)
+    and t.deleted_at is null and not t.completed
+    order by r.remind_at asc
+    limit $2
+    for update of r skip locked
 )
+update todoapp.reminder r
+set claimed_at = now()
+from due, todoapp.todo t
+where r.user_id = due.user_id and r.todo_id = due.todo_id
+and t.user_id = r.user_id and t.todo_id = r.todo_id
+returning r.user_id, r.todo_id, r.remind_at, r.claimed_at, t.todo, t.due_at
+`
+
+type ClaimDueRemindersParams struct {
+	ClaimExpiredBefore pgtype.Timestamptz
+	Limit              int32
+}
+
+type ClaimDueRemindersRow struct {
+	UserID    string
+	TodoID    string
+	RemindAt  pgtype.Timestamptz
+	ClaimedAt pgtype.Timestamptz
+	Todo      string
+	DueAt     pgtype.Timestamptz
+}
+
+func (q *Queries) ClaimDueReminders(ctx context.Context, arg ClaimDueRemindersParams) ([]ClaimDueRemindersRow, error) {
+	rows, err := q.db.Query(ctx, claimDueReminders, arg.ClaimExpiredBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueRemindersRow
+	for rows.Next() {
+		var i ClaimDueRemindersRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.TodoID,
+			&i.RemindAt,
+			&i.ClaimedAt,
+			&i.Todo,
+			&i.DueAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const complete = `-- name: Complete :one
+update todoapp.todo
+set completed = true, completed_at = coalesce(completed_at, now()), updated_at = now(), rrule = null
+where user_id = $1 and todo_id = $2 and deleted_at is null
+returning id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+`
+
+type CompleteParams struct {
+	UserID string
+	TodoID string
+}
+
+func (q *Queries) Complete(ctx context.Context, arg CompleteParams) (TodoappTodo, error) {
+	row := q.db.QueryRow(ctx, complete, arg.UserID, arg.TodoID)
+	var i TodoappTodo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Todo,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
+	)
+	return i, err
+}
+
+const copyTags = `-- name: CopyTags :exec
+insert into todoapp.todo_tag (user_id, todo_id, name)
+select user_id, $1::text, name
+from todoapp.todo_tag
+where user_id = $2 and todo_id = $3
+`
+
+type CopyTagsParams struct {
+	ToTodoID   string
+	UserID     string
+	FromTodoID string
+}
+
+func (q *Queries) CopyTags(ctx context.Context, arg CopyTagsParams) error {
+	_, err := q.db.Exec(ctx, copyTags, arg.ToTodoID, arg.UserID, arg.FromTodoID)
+	return err
+}
 
 const create = `-- name: Create :one
-insert into todoapp.todo (user_id, todo)
-values ($1, $2)
-returning id, user_id, todo_id, todo, created_at, updated_at
+insert into todoapp.todo (user_id, todo, due_at, list_id, parent_todo_id, rrule, remind_at, priority)
+values ($1, $2, $3, $4, $5, $6, $7, $8)
+returning id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
 `
 
 type CreateParams struct {
-	UserID string
-	Todo   string
+	UserID       string
+	Todo         string
+	DueAt        pgtype.Timestamptz
+	ListID       pgtype.Text
+	ParentTodoID pgtype.Text
+	Rrule        pgtype.Text
+	RemindAt     pgtype.Timestamptz
+	Priority     int16
 }
 
 func (q *Queries) Create(ctx context.Context, arg CreateParams) (TodoappTodo, error) {
-	row := q.db.QueryRow(ctx, create, arg.UserID, arg.Todo)
+	row := q.db.QueryRow(ctx, create, arg.UserID, arg.Todo, arg.DueAt, arg.ListID, arg.ParentTodoID, arg.Rrule, arg.RemindAt, arg.Priority)
 	var i TodoappTodo
 	err := row.Scan(
 		&i.ID,
@@ -30,66 +205,398 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (TodoappTodo, er
 		&i.Todo,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
 	)
 	return i, err
 }
 
-const delete = `-- name: Delete :exec
-delete from todoapp.todo
-where user_id = $1 and todo_id = $2
+const createAssignment = `-- name: CreateAssignment :one
+insert into todoapp.assignment (user_id, todo_id, assignee_id, assigned_by)
+values ($1, $2, $3, $4)
+returning id, user_id, todo_id, assignee_id, assigned_by, created_at
+`
+
+type CreateAssignmentParams struct {
+	UserID     string
+	TodoID     string
+	AssigneeID pgtype.Text
+	AssignedBy string
+}
+
+func (q *Queries) CreateAssignment(ctx context.Context, arg CreateAssignmentParams) (TodoappAssignment, error) {
+	row := q.db.QueryRow(ctx, createAssignment, arg.UserID, arg.TodoID, arg.AssigneeID, arg.AssignedBy)
+	var i TodoappAssignment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.AssigneeID,
+		&i.AssignedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createIdempotencyKey = `-- name: CreateIdempotencyKey :one
+insert into todoapp.idempotency_key as k (user_id, key, response, expires_at, request_hash)
+values ($1, $2, $3, $4, $5)
+on conflict (user_id, key) do update
+set response = excluded.response, created_at = now(), expires_at = excluded.expires_at, request_hash = excluded.request_hash
+where k.expires_at <= now()
+returning k.key
+`
+
+type CreateIdempotencyKeyParams struct {
+	UserID      string
+	Key         string
+	Response    []byte
+	ExpiresAt   pgtype.Timestamptz
+	RequestHash []byte
+}
+
+func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (string, error) {
+	row := q.db.QueryRow(ctx, createIdempotencyKey, arg.UserID, arg.Key, arg.Response, arg.ExpiresAt, arg.RequestHash)
+	var key string
+	err := row.Scan(&key)
+	return key, err
+}
+
+const createList = `-- name: CreateList :one
+insert into todoapp.list (user_id, name)
+values ($1, $2)
+returning id, user_id, list_id, name, created_at, updated_at
+`
+
+type CreateListParams struct {
+	UserID string
+	Name   string
+}
+
+func (q *Queries) CreateList(ctx context.Context, arg CreateListParams) (TodoappList, error) {
+	row := q.db.QueryRow(ctx, createList, arg.UserID, arg.Name)
+	var i TodoappList
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ListID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createOperation = `-- name: CreateOperation :exec
+insert into todoapp.operation (user_id, todo_id, kind, version, todo, due_at, parent_todo_id, rrule, remind_at, priority)
+select user_id, todo_id, $1::text, version, $2::text, $3::timestamptz, $4::text, $5::text, $6::timestamptz, $7::smallint
+from todoapp.todo
+where user_id = $8 and todo_id = $9
+`
+
+type CreateOperationParams struct {
+	Kind         string
+	Todo         pgtype.Text
+	DueAt        pgtype.Timestamptz
+	ParentTodoID pgtype.Text
+	Rrule        pgtype.Text
+	RemindAt     pgtype.Timestamptz
+	Priority     pgtype.Int2
+	UserID       string
+	TodoID       string
+}
+
+func (q *Queries) CreateOperation(ctx context.Context, arg CreateOperationParams) error {
+	_, err := q.db.Exec(ctx, createOperation, arg.Kind, arg.Todo, arg.DueAt, arg.ParentTodoID, arg.Rrule, arg.RemindAt, arg.Priority, arg.UserID, arg.TodoID)
+	return err
+}
+
+const createTags = `-- name: CreateTags :exec
+insert into todoapp.tag (user_id, name)
+select $1::text, unnest($2::text[])
+on conflict do nothing
+`
+
+type CreateTagsParams struct {
+	UserID string
+	Names  []string
+}
+
+func (q *Queries) CreateTags(ctx context.Context, arg CreateTagsParams) error {
+	_, err := q.db.Exec(ctx, createTags, arg.UserID, arg.Names)
+	return err
+}
+
+const delete = `-- name: Delete :execrows
+update todoapp.todo
+set deleted_at = now()
+where user_id = $1 and todo_id = $2 and deleted_at is null
+and ($3::bigint = 0 or version = $3)
 `
 
 type DeleteParams struct {
+	UserID          string
+	TodoID          string
+	ExpectedVersion int64
+}
+
+func (q *Queries) Delete(ctx context.Context, arg DeleteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, delete, arg.UserID, arg.TodoID, arg.ExpectedVersion)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteComment = `-- name: DeleteComment :exec
+delete from todoapp.comment
+where comment_id = $1
+`
+
+func (q *Queries) DeleteComment(ctx context.Context, commentID string) error {
+	_, err := q.db.Exec(ctx, deleteComment, commentID)
+	return err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+delete from todoapp.idempotency_key
+where expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteList = `-- name: DeleteList :exec
+delete from todoapp.list
+where user_id = $1 and list_id = $2
+`
+
+type DeleteListParams struct {
 	UserID string
-	TodoID string
+	ListID string
 }
 
-func (q *Queries) Delete(ctx context.Context, arg DeleteParams) error {
-	_, err := q.db.Exec(ctx, delete, arg.UserID, arg.TodoID)
+func (q *Queries) DeleteList(ctx context.Context, arg DeleteListParams) error {
+	_, err := q.db.Exec(ctx, deleteList, arg.UserID, arg.ListID)
 	return err
 }
 
-const read = `-- name: Read :one
-select id, user_id, todo_id, todo, created_at, updated_at
-from todoapp.todo
-where user_id = $1 and todo_id = $2
+const deleteOldOperations = `-- name: DeleteOldOperations :exec
+delete from todoapp.operation
+where user_id = $1 and id <= (
+    select id
+    from todoapp.operation
+    where user_id = $1
+    order by id desc
+    offset $2
+    limit 1
+)
 `
 
-type ReadParams struct {
+type DeleteOldOperationsParams struct {
 	UserID string
-	TodoID string
+	Offset int32
 }
 
-func (q *Queries) Read(ctx context.Context, arg ReadParams) (TodoappTodo, error) {
-	row := q.db.QueryRow(ctx, read, arg.UserID, arg.TodoID)
-	var i TodoappTodo
+func (q *Queries) DeleteOldOperations(ctx context.Context, arg DeleteOldOperationsParams) error {
+	_, err := q.db.Exec(ctx, deleteOldOperations, arg.UserID, arg.Offset)
+	return err
+}
+
+const deleteOldTodoEvents = `-- name: DeleteOldTodoEvents :execrows
+delete from todoapp.todo_event
+where created_at < $1
+`
+
+func (q *Queries) DeleteOldTodoEvents(ctx context.Context, createdAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOldTodoEvents, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOperation = `-- name: DeleteOperation :exec
+delete from todoapp.operation
+where id = $1
+`
+
+func (q *Queries) DeleteOperation(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteOperation, id)
+	return err
+}
+
+const deleteUnusedTags = `-- name: DeleteUnusedTags :exec
+delete from todoapp.tag t
+where t.user_id = $1 and t.name = any($2::text[])
+and not exists (
+    select 1
+    from todoapp.todo_tag tt
+    where tt.user_id = t.user_id and tt.name = t.name
+)
+`
+
+type DeleteUnusedTagsParams struct {
+	UserID string
+	Names  []string
+}
+
+func (q *Queries) DeleteUnusedTags(ctx context.Context, arg DeleteUnusedTagsParams) error {
+	_, err := q.db.Exec(ctx, deleteUnusedTags, arg.UserID, arg.Names)
+	return err
+}
+
+const detachListTodos = `-- name: DetachListTodos :exec
+update todoapp.todo
+set list_id = null
+where user_id = $1 and list_id = $2
+`
+
+type DetachListTodosParams struct {
+	UserID string
+	ListID string
+}
+
+func (q *Queries) DetachListTodos(ctx context.Context, arg DetachListTodosParams) error {
+	_, err := q.db.Exec(ctx, detachListTodos, arg.UserID, arg.ListID)
+	return err
+}
+
+const editComment = `-- name: EditComment :one
+update todoapp.comment
+set body = $2, updated_at = now()
+where comment_id = $1
+returning id, comment_id, owner_id, todo_id, user_id, body, created_at, updated_at
+`
+
+type EditCommentParams struct {
+	CommentID string
+	Body      string
+}
+
+func (q *Queries) EditComment(ctx context.Context, arg EditCommentParams) (TodoappComment, error) {
+	row := q.db.QueryRow(ctx, editComment, arg.CommentID, arg.Body)
+	var i TodoappComment
 	err := row.Scan(
 		&i.ID,
-		&i.UserID,
+		&i.CommentID,
+		&i.OwnerID,
 		&i.TodoID,
-		&i.Todo,
+		&i.UserID,
+		&i.Body,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const readPage = `-- name: ReadPage :many
-select id, user_id, todo_id, todo, created_at, updated_at
+const list = `-- name: List :many
+select id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
 from todoapp.todo
 where user_id = $1
-and id > $2
-order by id asc
-limit 100
+and deleted_at is null
+and ($2::boolean is null or completed = $2)
+and ($3::text = '' or strpos(lower(todo), lower($3)) > 0)
+and ($4::timestamptz is null or created_at > $4)
+and ($5::timestamptz is null or created_at < $5)
+and ($6::timestamptz is null or updated_at > $6)
+and ($7::timestamptz is null or updated_at < $7)
+and ($8::text is null or list_id = $8)
+and (cardinality($9::smallint[]) = 0 or priority = any($9::smallint[]))
+and (
+    not $10::boolean
+    or ($11::boolean and (
+        case $12::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end,
+        case when $12::text = 'priority' then priority else 0 end,
+        case when $12::text = 'position' then position else '' end,
+        id) < ($13::timestamptz, $14::smallint, $15::text, $16::bigint))
+    or (not $11::boolean and (
+        case $12::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end,
+        case when $12::text = 'priority' then priority else 0 end,
+        case when $12::text = 'position' then position else '' end,
+        id) > ($13::timestamptz, $14::smallint, $15::text, $16::bigint))
+)
+order by
+    case when $11::boolean then
+        case $12::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end
+    end desc,
+    case when $11::boolean and $12::text = 'priority' then priority end desc,
+    case when $11::boolean and $12::text = 'position' then position end desc,
+    case when $11::boolean then id end desc,
+    case when not $11::boolean then
+        case $12::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end
+    end asc,
+    case when not $11::boolean and $12::text = 'priority' then priority end asc,
+    case when not $11::boolean and $12::text = 'position' then position end asc,
+    case when not $11::boolean then id end asc
+limit $17
 `
 
-type ReadPageParams struct {
-	UserID string
-	ID     int64
+type ListParams struct {
+	UserID         string
+	Completed      pgtype.Bool
+	TextContains   string
+	CreatedAfter   pgtype.Timestamptz
+	CreatedBefore  pgtype.Timestamptz
+	UpdatedAfter   pgtype.Timestamptz
+	UpdatedBefore  pgtype.Timestamptz
+	ListID         pgtype.Text
+	Priorities     []int16
+	HasCursor      bool
+	Descending     bool
+	SortBy         string
+	CursorKey      pgtype.Timestamptz
+	CursorPriority int16
+	CursorPosition string
+	CursorID       int64
+	Limit          int32
 }
 
-func (q *Queries) ReadPage(ctx context.Context, arg ReadPageParams) ([]TodoappTodo, error) {
-	rows, err := q.db.Query(ctx, readPage, arg.UserID, arg.ID)
+func (q *Queries) List(ctx context.Context, arg ListParams) ([]TodoappTodo, error) {
+	rows, err := q.db.Query(ctx, list, arg.UserID, arg.Completed, arg.TextContains, arg.CreatedAfter, arg.CreatedBefore, arg.UpdatedAfter, arg.UpdatedBefore, arg.ListID, arg.Priorities, arg.HasCursor, arg.Descending, arg.SortBy, arg.CursorKey, arg.CursorPriority, arg.CursorPosition, arg.CursorID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +611,60 @@ func (q *Queries) ReadPage(ctx context.Context, arg ReadPageParams) ([]TodoappTo
 			&i.Todo,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Completed,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.ListID,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+			&i.ChangeSeq,
+			&i.ParentTodoID,
+			&i.ChildCount,
+			&i.CompletedChildCount,
+			&i.Position,
+			&i.Rrule,
+			&i.RemindAt,
+			&i.Priority,
+			&i.AssigneeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTags = `-- name: ListTags :many
+select t.name, count(td.id) as todo_count
+from todoapp.tag t
+left join todoapp.todo_tag tt on tt.user_id = t.user_id and tt.name = t.name
+left join todoapp.todo td on td.user_id = tt.user_id and td.todo_id = tt.todo_id and td.deleted_at is null
+where t.user_id = $1
+group by t.name
+order by t.name asc
+`
+
+type ListTagsRow struct {
+	Name      string
+	TodoCount int64
+}
+
+func (q *Queries) ListTags(ctx context.Context, userID string) ([]ListTagsRow, error) {
+	rows, err := q.db.Query(ctx, listTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagsRow
+	for rows.Next() {
+		var i ListTagsRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.TodoCount,
 		); err != nil {
 			return nil, err
 		}
@@ -115,21 +676,50 @@ func (q *Queries) ReadPage(ctx context.Context, arg ReadPageParams) ([]TodoappTo
 	return items, nil
 }
 
-const update = `-- name: Update :one
+const lockUserTodos = `-- name: LockUserTodos :exec
+select seq
+from todoapp.change_seq
+where user_id = $1
+for update
+`
+
+func (q *Queries) LockUserTodos(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, lockUserTodos, userID)
+	return err
+}
+
+const markReminderSent = `-- name: MarkReminderSent :exec
+update todoapp.reminder
+set sent_at = now(), claimed_at = null
+where user_id = $1 and todo_id = $2 and claimed_at = $3
+`
+
+type MarkReminderSentParams struct {
+	UserID    string
+	TodoID    string
+	ClaimedAt pgtype.Timestamptz
+}
+
+func (q *Queries) MarkReminderSent(ctx context.Context, arg MarkReminderSentParams) error {
+	_, err := q.db.Exec(ctx, markReminderSent, arg.UserID, arg.TodoID, arg.ClaimedAt)
+	return err
+}
+
+const moveToList = `-- name: MoveToList :one
 update todoapp.todo
-set todo = $1, updated_at = NOW()
-where user_id  = $2 AND todo_id = $3
-returning id, user_id, todo_id, todo, created_at, updated_at
+set list_id = $1, updated_at = now()
+where user_id = $2 and todo_id = $3 and deleted_at is null
+returning id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
 `
 
-type UpdateParams struct {
-	Todo   string
+type MoveToListParams struct {
+	ListID pgtype.Text
 	UserID string
 	TodoID string
 }
 
-func (q *Queries) Update(ctx context.Context, arg UpdateParams) (TodoappTodo, error) {
-	row := q.db.QueryRow(ctx, update, arg.Todo, arg.UserID, arg.TodoID)
+func (q *Queries) MoveToList(ctx context.Context, arg MoveToListParams) (TodoappTodo, error) {
+	row := q.db.QueryRow(ctx, moveToList, arg.ListID, arg.UserID, arg.TodoID)
 	var i TodoappTodo
 	err := row.Scan(
 		&i.ID,
@@ -138,6 +728,1458 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) (TodoappTodo, er
 		&i.Todo,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
+	)
+	return i, err
+}
+
+const purgeExpiredTrash = `-- name: PurgeExpiredTrash :execrows
+delete from todoapp.todo
+where deleted_at < $1
+`
+
+func (q *Queries) PurgeExpiredTrash(ctx context.Context, deletedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeExpiredTrash, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeTrash = `-- name: PurgeTrash :execrows
+delete from todoapp.todo
+where user_id = $1 and deleted_at is not null
+`
+
+func (q *Queries) PurgeTrash(ctx context.Context, userID string) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrash, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const read = `-- name: Read :one
+select id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+from todoapp.todo
+where user_id = $1 and todo_id = $2 and deleted_at is null
+`
+
+type ReadParams struct {
+	UserID string
+	TodoID string
+}
+
+func (q *Queries) Read(ctx context.Context, arg ReadParams) (TodoappTodo, error) {
+	row := q.db.QueryRow(ctx, read, arg.UserID, arg.TodoID)
+	var i TodoappTodo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Todo,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
+	)
+	return i, err
+}
+
+const readAllLists = `-- name: ReadAllLists :many
+select l.id, l.user_id, l.list_id, l.name, l.created_at, l.updated_at
+from todoapp.list l
+where l.user_id = $1
+or exists (
+    select 1
+    from todoapp.list_share s
+    where s.owner_id = l.user_id and s.list_id = l.list_id and s.user_id = $1
+)
+order by l.id asc
+`
+
+func (q *Queries) ReadAllLists(ctx context.Context, userID string) ([]TodoappList, error) {
+	rows, err := q.db.Query(ctx, readAllLists, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappList
+	for rows.Next() {
+		var i TodoappList
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ListID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAssignedTodos = `-- name: ReadAssignedTodos :many
+select t.id, t.user_id, t.todo_id, t.todo, t.created_at, t.updated_at, t.completed, t.completed_at, t.due_at, t.list_id, t.search_vector, t.version, t.deleted_at, t.change_seq, t.parent_todo_id, t.child_count, t.completed_child_count, t.position, t.rrule, t.remind_at, t.priority, t.assignee_id, a.assigned_by, a.created_at as assigned_at
+from todoapp.todo t
+join lateral (
+    select assigned_by, created_at
+    from todoapp.assignment
+    where user_id = t.user_id and todo_id = t.todo_id
+    order by id desc
+    limit 1
+) a on true
+where t.assignee_id = $1
+and t.deleted_at is null
+and t.id > $2
+and ($3::boolean is null or t.completed = $3)
+and (t.user_id = $1 or exists (
+    select 1
+    from todoapp.list_share s
+    where s.owner_id = t.user_id and s.list_id = t.list_id and s.user_id = $1
+))
+order by t.id asc
+limit $4
+`
+
+type ReadAssignedTodosParams struct {
+	UserID    string
+	ID        int64
+	Completed pgtype.Bool
+	Limit     int32
+}
+
+type ReadAssignedTodosRow struct {
+	TodoappTodo TodoappTodo
+	AssignedBy  string
+	AssignedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) ReadAssignedTodos(ctx context.Context, arg ReadAssignedTodosParams) ([]ReadAssignedTodosRow, error) {
+	rows, err := q.db.Query(ctx, readAssignedTodos, arg.UserID, arg.ID, arg.Completed, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAssignedTodosRow
+	for rows.Next() {
+		var i ReadAssignedTodosRow
+		if err := rows.Scan(
+			&i.TodoappTodo.ID,
+			&i.TodoappTodo.UserID,
+			&i.TodoappTodo.TodoID,
+			&i.TodoappTodo.Todo,
+			&i.TodoappTodo.CreatedAt,
+			&i.TodoappTodo.UpdatedAt,
+			&i.TodoappTodo.Completed,
+			&i.TodoappTodo.CompletedAt,
+			&i.TodoappTodo.DueAt,
+			&i.TodoappTodo.ListID,
+			&i.TodoappTodo.SearchVector,
+			&i.TodoappTodo.Version,
+			&i.TodoappTodo.DeletedAt,
+			&i.TodoappTodo.ChangeSeq,
+			&i.TodoappTodo.ParentTodoID,
+			&i.TodoappTodo.ChildCount,
+			&i.TodoappTodo.CompletedChildCount,
+			&i.TodoappTodo.Position,
+			&i.TodoappTodo.Rrule,
+			&i.TodoappTodo.RemindAt,
+			&i.TodoappTodo.Priority,
+			&i.TodoappTodo.AssigneeID,
+			&i.AssignedBy,
+			&i.AssignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAssignmentPage = `-- name: ReadAssignmentPage :many
+select id, user_id, todo_id, assignee_id, assigned_by, created_at
+from todoapp.assignment
+where user_id = $1 and todo_id = $2 and id > $3
+order by id asc
+limit $4
+`
+
+type ReadAssignmentPageParams struct {
+	UserID string
+	TodoID string
+	ID     int64
+	Limit  int32
+}
+
+func (q *Queries) ReadAssignmentPage(ctx context.Context, arg ReadAssignmentPageParams) ([]TodoappAssignment, error) {
+	rows, err := q.db.Query(ctx, readAssignmentPage, arg.UserID, arg.TodoID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappAssignment
+	for rows.Next() {
+		var i TodoappAssignment
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TodoID,
+			&i.AssigneeID,
+			&i.AssignedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readCollaborators = `-- name: ReadCollaborators :many
+select owner_id, list_id, user_id, role, created_at
+from todoapp.list_share
+where owner_id = $1 and list_id = $2
+order by created_at asc, user_id asc
+`
+
+type ReadCollaboratorsParams struct {
+	OwnerID string
+	ListID  string
+}
+
+func (q *Queries) ReadCollaborators(ctx context.Context, arg ReadCollaboratorsParams) ([]TodoappListShare, error) {
+	rows, err := q.db.Query(ctx, readCollaborators, arg.OwnerID, arg.ListID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappListShare
+	for rows.Next() {
+		var i TodoappListShare
+		if err := rows.Scan(
+			&i.OwnerID,
+			&i.ListID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readComment = `-- name: ReadComment :one
+select id, comment_id, owner_id, todo_id, user_id, body, created_at, updated_at
+from todoapp.comment
+where comment_id = $1
+`
+
+func (q *Queries) ReadComment(ctx context.Context, commentID string) (TodoappComment, error) {
+	row := q.db.QueryRow(ctx, readComment, commentID)
+	var i TodoappComment
+	err := row.Scan(
+		&i.ID,
+		&i.CommentID,
+		&i.OwnerID,
+		&i.TodoID,
+		&i.UserID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const readCommentPage = `-- name: ReadCommentPage :many
+select id, comment_id, owner_id, todo_id, user_id, body, created_at, updated_at
+from todoapp.comment
+where owner_id = $1 and todo_id = $2 and id > $3
+order by id asc
+limit $4
+`
+
+type ReadCommentPageParams struct {
+	OwnerID string
+	TodoID  string
+	ID      int64
+	Limit   int32
+}
+
+func (q *Queries) ReadCommentPage(ctx context.Context, arg ReadCommentPageParams) ([]TodoappComment, error) {
+	rows, err := q.db.Query(ctx, readCommentPage, arg.OwnerID, arg.TodoID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappComment
+	for rows.Next() {
+		var i TodoappComment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommentID,
+			&i.OwnerID,
+			&i.TodoID,
+			&i.UserID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readForUpdate = `-- name: ReadForUpdate :one
+select id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+from todoapp.todo
+where user_id = $1 and todo_id = $2 and deleted_at is null
+for update
+`
+
+type ReadForUpdateParams struct {
+	UserID string
+	TodoID string
+}
+
+func (q *Queries) ReadForUpdate(ctx context.Context, arg ReadForUpdateParams) (TodoappTodo, error) {
+	row := q.db.QueryRow(ctx, readForUpdate, arg.UserID, arg.TodoID)
+	var i TodoappTodo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Todo,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
+	)
+	return i, err
+}
+
+const readIdempotencyKey = `-- name: ReadIdempotencyKey :one
+select response, request_hash
+from todoapp.idempotency_key
+where user_id = $1 and key = $2 and expires_at > now()
+`
+
+type ReadIdempotencyKeyParams struct {
+	UserID string
+	Key    string
+}
+
+type ReadIdempotencyKeyRow struct {
+	Response    []byte
+	RequestHash []byte
+}
+
+func (q *Queries) ReadIdempotencyKey(ctx context.Context, arg ReadIdempotencyKeyParams) (ReadIdempotencyKeyRow, error) {
+	row := q.db.QueryRow(ctx, readIdempotencyKey, arg.UserID, arg.Key)
+	var i ReadIdempotencyKeyRow
+	err := row.Scan(
+		&i.Response,
+		&i.RequestHash,
+	)
+	return i, err
+}
+
+const readLatestOperation = `-- name: ReadLatestOperation :one
+select id, user_id, todo_id, kind, version, todo, due_at, created_at, parent_todo_id, rrule, remind_at, priority
+from todoapp.operation
+where user_id = $1
+order by id desc
+limit 1
+for update
+`
+
+func (q *Queries) ReadLatestOperation(ctx context.Context, userID string) (TodoappOperation, error) {
+	row := q.db.QueryRow(ctx, readLatestOperation, userID)
+	var i TodoappOperation
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Kind,
+		&i.Version,
+		&i.Todo,
+		&i.DueAt,
+		&i.CreatedAt,
+		&i.ParentTodoID,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+	)
+	return i, err
+}
+
+const readLatestTodoEventSeq = `-- name: ReadLatestTodoEventSeq :one
+select coalesce(max(seq), 0)::bigint as seq
+from todoapp.todo_event
+where user_id = $1
+`
+
+func (q *Queries) ReadLatestTodoEventSeq(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRow(ctx, readLatestTodoEventSeq, userID)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+const readList = `-- name: ReadList :one
+select id, user_id, list_id, name, created_at, updated_at
+from todoapp.list
+where user_id = $1 and list_id = $2
+`
+
+type ReadListParams struct {
+	UserID string
+	ListID string
+}
+
+func (q *Queries) ReadList(ctx context.Context, arg ReadListParams) (TodoappList, error) {
+	row := q.db.QueryRow(ctx, readList, arg.UserID, arg.ListID)
+	var i TodoappList
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ListID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const readListForUpdate = `-- name: ReadListForUpdate :one
+select id, user_id, list_id, name, created_at, updated_at
+from todoapp.list
+where user_id = $1 and list_id = $2
+for update
+`
+
+type ReadListForUpdateParams struct {
+	UserID string
+	ListID string
+}
+
+func (q *Queries) ReadListForUpdate(ctx context.Context, arg ReadListForUpdateParams) (TodoappList, error) {
+	row := q.db.QueryRow(ctx, readListForUpdate, arg.UserID, arg.ListID)
+	var i TodoappList
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ListID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const readListRole = `-- name: ReadListRole :one
+select user_id as owner_id, 'owner'::text as role
+from todoapp.list
+where user_id = $1 and list_id = $2
+union all
+select owner_id, role
+from todoapp.list_share
+where user_id = $1 and list_id = $2
+limit 1
+`
+
+type ReadListRoleParams struct {
+	UserID string
+	ListID string
+}
+
+type ReadListRoleRow struct {
+	OwnerID string
+	Role    string
+}
+
+func (q *Queries) ReadListRole(ctx context.Context, arg ReadListRoleParams) (ReadListRoleRow, error) {
+	row := q.db.QueryRow(ctx, readListRole, arg.UserID, arg.ListID)
+	var i ReadListRoleRow
+	err := row.Scan(
+		&i.OwnerID,
+		&i.Role,
+	)
+	return i, err
+}
+
+const readPage = `-- name: ReadPage :many
+select id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+from todoapp.todo
+where user_id = $1
+and deleted_at is null
+and id > $2
+and ($3::timestamptz is null or due_at < $3)
+and ($4::timestamptz is null or due_at > $4)
+and (not $5::boolean or (due_at < now() and not completed))
+and (not $6::boolean or due_at is null)
+and ($7::text is null or exists (
+    select 1
+    from todoapp.todo_tag tt
+    where tt.user_id = todo.user_id and tt.todo_id = todo.todo_id and tt.name = $7
+))
+and ($8::text is null or list_id = $8)
+order by id asc
+limit $9
+`
+
+type ReadPageParams struct {
+	UserID    string
+	ID        int64
+	DueBefore pgtype.Timestamptz
+	DueAfter  pgtype.Timestamptz
+	Overdue   bool
+	NoDueDate bool
+	Tag       pgtype.Text
+	ListID    pgtype.Text
+	Limit     int32
+}
+
+func (q *Queries) ReadPage(ctx context.Context, arg ReadPageParams) ([]TodoappTodo, error) {
+	rows, err := q.db.Query(ctx, readPage, arg.UserID, arg.ID, arg.DueBefore, arg.DueAfter, arg.Overdue, arg.NoDueDate, arg.Tag, arg.ListID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappTodo
+	for rows.Next() {
+		var i TodoappTodo
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TodoID,
+			&i.Todo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Completed,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.ListID,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+			&i.ChangeSeq,
+			&i.ParentTodoID,
+			&i.ChildCount,
+			&i.CompletedChildCount,
+			&i.Position,
+			&i.Rrule,
+			&i.RemindAt,
+			&i.Priority,
+			&i.AssigneeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readPositionAfter = `-- name: ReadPositionAfter :one
+select position
+from todoapp.todo
+where user_id = $1
+and list_id is not distinct from $2
+and deleted_at is null
+and todo_id <> $3
+and (position, id) > ($4::text, $5::bigint)
+order by position asc, id asc
+limit 1
+`
+
+type ReadPositionAfterParams struct {
+	UserID   string
+	ListID   pgtype.Text
+	TodoID   string
+	Position string
+	ID       int64
+}
+
+func (q *Queries) ReadPositionAfter(ctx context.Context, arg ReadPositionAfterParams) (string, error) {
+	row := q.db.QueryRow(ctx, readPositionAfter, arg.UserID, arg.ListID, arg.TodoID, arg.Position, arg.ID)
+	var position string
+	err := row.Scan(&position)
+	return position, err
+}
+
+const readPositionBefore = `-- name: ReadPositionBefore :one
+select position
+from todoapp.todo
+where user_id = $1
+and list_id is not distinct from $2
+and deleted_at is null
+and todo_id <> $3
+and (position, id) < ($4::text, $5::bigint)
+order by position desc, id desc
+limit 1
+`
+
+type ReadPositionBeforeParams struct {
+	UserID   string
+	ListID   pgtype.Text
+	TodoID   string
+	Position string
+	ID       int64
+}
+
+func (q *Queries) ReadPositionBefore(ctx context.Context, arg ReadPositionBeforeParams) (string, error) {
+	row := q.db.QueryRow(ctx, readPositionBefore, arg.UserID, arg.ListID, arg.TodoID, arg.Position, arg.ID)
+	var position string
+	err := row.Scan(&position)
+	return position, err
+}
+
+const readPositionByID = `-- name: ReadPositionByID :one
+select position
+from todoapp.todo
+where user_id = $1 and id = $2
+`
+
+type ReadPositionByIDParams struct {
+	UserID string
+	ID     int64
+}
+
+func (q *Queries) ReadPositionByID(ctx context.Context, arg ReadPositionByIDParams) (string, error) {
+	row := q.db.QueryRow(ctx, readPositionByID, arg.UserID, arg.ID)
+	var position string
+	err := row.Scan(&position)
+	return position, err
+}
+
+const readRevision = `-- name: ReadRevision :one
+select id, user_id, todo_id, version, todo, due_at, created_at
+from todoapp.todo_revision
+where user_id = $1 and todo_id = $2 and version = $3
+`
+
+type ReadRevisionParams struct {
+	UserID  string
+	TodoID  string
+	Version int64
+}
+
+func (q *Queries) ReadRevision(ctx context.Context, arg ReadRevisionParams) (TodoappTodoRevision, error) {
+	row := q.db.QueryRow(ctx, readRevision, arg.UserID, arg.TodoID, arg.Version)
+	var i TodoappTodoRevision
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Version,
+		&i.Todo,
+		&i.DueAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const readRevisionPage = `-- name: ReadRevisionPage :many
+select id, user_id, todo_id, version, todo, due_at, created_at
+from todoapp.todo_revision
+where user_id = $1 and todo_id = $2 and id > $3
+order by id asc
+limit $4
+`
+
+type ReadRevisionPageParams struct {
+	UserID string
+	TodoID string
+	ID     int64
+	Limit  int32
+}
+
+func (q *Queries) ReadRevisionPage(ctx context.Context, arg ReadRevisionPageParams) ([]TodoappTodoRevision, error) {
+	rows, err := q.db.Query(ctx, readRevisionPage, arg.UserID, arg.TodoID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappTodoRevision
+	for rows.Next() {
+		var i TodoappTodoRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TodoID,
+			&i.Version,
+			&i.Todo,
+			&i.DueAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readTodoChanges = `-- name: ReadTodoChanges :many
+select id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+from todoapp.todo
+where user_id = $1 and change_seq > $2
+order by change_seq asc
+limit $3
+`
+
+type ReadTodoChangesParams struct {
+	UserID    string
+	ChangeSeq int64
+	Limit     int32
+}
+
+func (q *Queries) ReadTodoChanges(ctx context.Context, arg ReadTodoChangesParams) ([]TodoappTodo, error) {
+	rows, err := q.db.Query(ctx, readTodoChanges, arg.UserID, arg.ChangeSeq, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappTodo
+	for rows.Next() {
+		var i TodoappTodo
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TodoID,
+			&i.Todo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Completed,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.ListID,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+			&i.ChangeSeq,
+			&i.ParentTodoID,
+			&i.ChildCount,
+			&i.CompletedChildCount,
+			&i.Position,
+			&i.Rrule,
+			&i.RemindAt,
+			&i.Priority,
+			&i.AssigneeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readTodoEvents = `-- name: ReadTodoEvents :many
+select seq, todo_id, kind, created_at
+from todoapp.todo_event
+where user_id = $1 and seq > $2
+order by seq asc
+limit $3
+`
+
+type ReadTodoEventsParams struct {
+	UserID string
+	Seq    int64
+	Limit  int32
+}
+
+type ReadTodoEventsRow struct {
+	Seq       int64
+	TodoID    string
+	Kind      string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ReadTodoEvents(ctx context.Context, arg ReadTodoEventsParams) ([]ReadTodoEventsRow, error) {
+	rows, err := q.db.Query(ctx, readTodoEvents, arg.UserID, arg.Seq, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadTodoEventsRow
+	for rows.Next() {
+		var i ReadTodoEventsRow
+		if err := rows.Scan(
+			&i.Seq,
+			&i.TodoID,
+			&i.Kind,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readTodoRole = `-- name: ReadTodoRole :one
+select t.user_id as owner_id, coalesce(s.role, 'owner')::text as role
+from todoapp.todo t
+left join todoapp.list_share s on s.owner_id = t.user_id and s.list_id = t.list_id and s.user_id = $1
+where t.todo_id = $2 and t.deleted_at is null
+and (t.user_id = $1 or s.user_id is not null)
+order by t.user_id = $1 desc
+limit 1
+`
+
+type ReadTodoRoleParams struct {
+	UserID string
+	TodoID string
+}
+
+type ReadTodoRoleRow struct {
+	OwnerID string
+	Role    string
+}
+
+func (q *Queries) ReadTodoRole(ctx context.Context, arg ReadTodoRoleParams) (ReadTodoRoleRow, error) {
+	row := q.db.QueryRow(ctx, readTodoRole, arg.UserID, arg.TodoID)
+	var i ReadTodoRoleRow
+	err := row.Scan(
+		&i.OwnerID,
+		&i.Role,
+	)
+	return i, err
+}
+
+const readTodoTags = `-- name: ReadTodoTags :many
+select name
+from todoapp.todo_tag
+where user_id = $1 and todo_id = $2
+order by name asc
+`
+
+type ReadTodoTagsParams struct {
+	UserID string
+	TodoID string
+}
+
+func (q *Queries) ReadTodoTags(ctx context.Context, arg ReadTodoTagsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, readTodoTags, arg.UserID, arg.TodoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readTombstones = `-- name: ReadTombstones :many
+select user_id, todo_id, change_seq, deleted_at
+from todoapp.todo_tombstone
+where user_id = $1 and change_seq > $2
+order by change_seq asc
+limit $3
+`
+
+type ReadTombstonesParams struct {
+	UserID    string
+	ChangeSeq int64
+	Limit     int32
+}
+
+func (q *Queries) ReadTombstones(ctx context.Context, arg ReadTombstonesParams) ([]TodoappTodoTombstone, error) {
+	rows, err := q.db.Query(ctx, readTombstones, arg.UserID, arg.ChangeSeq, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappTodoTombstone
+	for rows.Next() {
+		var i TodoappTodoTombstone
+		if err := rows.Scan(
+			&i.UserID,
+			&i.TodoID,
+			&i.ChangeSeq,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readTrashPage = `-- name: ReadTrashPage :many
+select id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+from todoapp.todo
+where user_id = $1
+and deleted_at is not null
+and id > $2
+order by id asc
+limit $3
+`
+
+type ReadTrashPageParams struct {
+	UserID string
+	ID     int64
+	Limit  int32
+}
+
+func (q *Queries) ReadTrashPage(ctx context.Context, arg ReadTrashPageParams) ([]TodoappTodo, error) {
+	rows, err := q.db.Query(ctx, readTrashPage, arg.UserID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappTodo
+	for rows.Next() {
+		var i TodoappTodo
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TodoID,
+			&i.Todo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Completed,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.ListID,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+			&i.ChangeSeq,
+			&i.ParentTodoID,
+			&i.ChildCount,
+			&i.CompletedChildCount,
+			&i.Position,
+			&i.Rrule,
+			&i.RemindAt,
+			&i.Priority,
+			&i.AssigneeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readTree = `-- name: ReadTree :many
+with recursive tree (todo_id) as (
+    select todo_id
+    from todoapp.todo
+    where user_id = $1 and todo_id = $2 and deleted_at is null
+    union
+    select t.todo_id
+    from todoapp.todo t
+    join tree on t.parent_todo_id = tree.todo_id
+    where t.user_id = $1 and t.deleted_at is null
+)
+select id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+from todoapp.todo
+where user_id = $1 and todo_id in (select todo_id from tree)
+order by id asc
+`
+
+type ReadTreeParams struct {
+	UserID string
+	TodoID string
+}
+
+func (q *Queries) ReadTree(ctx context.Context, arg ReadTreeParams) ([]TodoappTodo, error) {
+	rows, err := q.db.Query(ctx, readTree, arg.UserID, arg.TodoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TodoappTodo
+	for rows.Next() {
+		var i TodoappTodo
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TodoID,
+			&i.Todo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Completed,
+			&i.CompletedAt,
+			&i.DueAt,
+			&i.ListID,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+			&i.ChangeSeq,
+			&i.ParentTodoID,
+			&i.ChildCount,
+			&i.CompletedChildCount,
+			&i.Position,
+			&i.Rrule,
+			&i.RemindAt,
+			&i.Priority,
+			&i.AssigneeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseReminder = `-- name: ReleaseReminder :exec
+update todoapp.reminder
+set claimed_at = null
+where user_id = $1 and todo_id = $2 and claimed_at = $3
+`
+
+type ReleaseReminderParams struct {
+	UserID    string
+	TodoID    string
+	ClaimedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ReleaseReminder(ctx context.Context, arg ReleaseReminderParams) error {
+	_, err := q.db.Exec(ctx, releaseReminder, arg.UserID, arg.TodoID, arg.ClaimedAt)
+	return err
+}
+
+const removeTags = `-- name: RemoveTags :exec
+delete from todoapp.todo_tag
+where user_id = $1 and todo_id = $2 and name = any($3::text[])
+`
+
+type RemoveTagsParams struct {
+	UserID string
+	TodoID string
+	Names  []string
+}
+
+func (q *Queries) RemoveTags(ctx context.Context, arg RemoveTagsParams) error {
+	_, err := q.db.Exec(ctx, removeTags, arg.UserID, arg.TodoID, arg.Names)
+	return err
+}
+
+const renumberPositions = `-- name: RenumberPositions :exec
+select todoapp.renumber_positions($1, $2)
+`
+
+type RenumberPositionsParams struct {
+	UserID string
+	ListID pgtype.Text
+}
+
+func (q *Queries) RenumberPositions(ctx context.Context, arg RenumberPositionsParams) error {
+	_, err := q.db.Exec(ctx, renumberPositions, arg.UserID, arg.ListID)
+	return err
+}
+
+const reopen = `-- name: Reopen :one
+update todoapp.todo
+set completed = false, completed_at = null, updated_at = now()
+where user_id = $1 and todo_id = $2 and deleted_at is null
+returning id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+`
+
+type ReopenParams struct {
+	UserID string
+	TodoID string
+}
+
+func (q *Queries) Reopen(ctx context.Context, arg ReopenParams) (TodoappTodo, error) {
+	row := q.db.QueryRow(ctx, reopen, arg.UserID, arg.TodoID)
+	var i TodoappTodo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Todo,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
+	)
+	return i, err
+}
+
+const restore = `-- name: Restore :one
+update todoapp.todo
+set deleted_at = null, updated_at = now()
+where user_id = $1 and todo_id = $2 and deleted_at is not null
+and ($3::bigint = 0 or version = $3)
+returning id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+`
+
+type RestoreParams struct {
+	UserID          string
+	TodoID          string
+	ExpectedVersion int64
+}
+
+func (q *Queries) Restore(ctx context.Context, arg RestoreParams) (TodoappTodo, error) {
+	row := q.db.QueryRow(ctx, restore, arg.UserID, arg.TodoID, arg.ExpectedVersion)
+	var i TodoappTodo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Todo,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
+	)
+	return i, err
+}
+
+const search = `-- name: Search :many
+select t.id, t.user_id, t.todo_id, t.todo, t.created_at, t.updated_at, t.completed, t.completed_at, t.due_at, t.list_id, t.search_vector, t.version, t.deleted_at, t.change_seq, t.parent_todo_id, t.child_count, t.completed_child_count, t.position, t.rrule, t.remind_at, t.priority, t.assignee_id, ts_rank(t.search_vector, q)::real as rank, ts_headline('english', t.todo, q)::text as snippet
+from todoapp.todo t, websearch_to_tsquery('english', $1::text) q
+where t.user_id = $2 and t.deleted_at is null and t.search_vector @@ q
+order by rank desc, t.id asc
+limit $3
+`
+
+type SearchParams struct {
+	Query  string
+	UserID string
+	Limit  int32
+}
+
+type SearchRow struct {
+	TodoappTodo TodoappTodo
+	Rank        float32
+	Snippet     string
+}
+
+func (q *Queries) Search(ctx context.Context, arg SearchParams) ([]SearchRow, error) {
+	rows, err := q.db.Query(ctx, search, arg.Query, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchRow
+	for rows.Next() {
+		var i SearchRow
+		if err := rows.Scan(
+			&i.TodoappTodo.ID,
+			&i.TodoappTodo.UserID,
+			&i.TodoappTodo.TodoID,
+			&i.TodoappTodo.Todo,
+			&i.TodoappTodo.CreatedAt,
+			&i.TodoappTodo.UpdatedAt,
+			&i.TodoappTodo.Completed,
+			&i.TodoappTodo.CompletedAt,
+			&i.TodoappTodo.DueAt,
+			&i.TodoappTodo.ListID,
+			&i.TodoappTodo.SearchVector,
+			&i.TodoappTodo.Version,
+			&i.TodoappTodo.DeletedAt,
+			&i.TodoappTodo.ChangeSeq,
+			&i.TodoappTodo.ParentTodoID,
+			&i.TodoappTodo.ChildCount,
+			&i.TodoappTodo.CompletedChildCount,
+			&i.TodoappTodo.Position,
+			&i.TodoappTodo.Rrule,
+			&i.TodoappTodo.RemindAt,
+			&i.TodoappTodo.Priority,
+			&i.TodoappTodo.AssigneeID,
+			&i.Rank,
+			&i.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setPosition = `-- name: SetPosition :one
+update todoapp.todo
+set position = $3, updated_at = now()
+where user_id = $1 and todo_id = $2 and deleted_at is null
+returning id, user_id, todo_id, todo, created_at, updated_at, completed, completed_at, due_at, list_id, search_vector, version, deleted_at, change_seq, parent_todo_id, child_count, completed_child_count, position, rrule, remind_at, priority, assignee_id
+`
+
+type SetPositionParams struct {
+	UserID   string
+	TodoID   string
+	Position string
+}
+
+func (q *Queries) SetPosition(ctx context.Context, arg SetPositionParams) (TodoappTodo, error) {
+	row := q.db.QueryRow(ctx, setPosition, arg.UserID, arg.TodoID, arg.Position)
+	var i TodoappTodo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TodoID,
+		&i.Todo,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Completed,
+		&i.CompletedAt,
+		&i.DueAt,
+		&i.ListID,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+		&i.ChangeSeq,
+		&i.ParentTodoID,
+		&i.ChildCount,
+		&i.CompletedChildCount,
+		&i.Position,
+		&i.Rrule,
+		&i.RemindAt,
+		&i.Priority,
+		&i.AssigneeID,
+	)
+	return i, err
+}
+
+const setPreviousOperationVersion = `-- name: SetPreviousOperationVersion :exec
+update todoapp.operation
+set version = $1
+where id = (
+    select max(id)
+    from todoapp.operation
+    where user_id = $2 and todo_id = $3
+)
+`
+
+type SetPreviousOperationVersionParams struct {
+	Version int64
+	UserID  string
+	TodoID  string
+}
+
+func (q *Queries) SetPreviousOperationVersion(ctx context.Context, arg SetPreviousOperationVersionParams) error {
+	_, err := q.db.Exec(ctx, setPreviousOperationVersion, arg.Version, arg.UserID, arg.TodoID)
+	return err
+}
+
+const shareList = `-- name: ShareList :one
+insert into todoapp.list_share (owner_id, list_id, user_id, role)
+values ($1, $2, $3, $4)
+on conflict (owner_id, list_id, user_id) do update
+set role = excluded.role
+returning owner_id, list_id, user_id, role, created_at
+`
+
+type ShareListParams struct {
+	OwnerID string
+	ListID  string
+	UserID  string
+	Role    string
+}
+
+func (q *Queries) ShareList(ctx context.Context, arg ShareListParams) (TodoappListShare, error) {
+	row := q.db.QueryRow(ctx, shareList, arg.OwnerID, arg.ListID, arg.UserID, arg.Role)
+	var i TodoappListShare
+	err := row.Scan(
+		&i.OwnerID,
+		&i.ListID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const trashListTodos = `-- name: TrashListTodos :many
+update todoapp.todo
+set deleted_at = now(), list_id = null
+where user_id = $1 and list_id = $2 and deleted_at is null
+returning todo_id
+`
+
+type TrashListTodosParams struct {
+	UserID string
+	ListID string
+}
+
+func (q *Queries) TrashListTodos(ctx context.Context, arg TrashListTodosParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, trashListTodos, arg.UserID, arg.ListID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var todoID string
+		if err := rows.Scan(&todoID); err != nil {
+			return nil, err
+		}
+		items = append(items, todoID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unshare = `-- name: Unshare :exec
+delete from todoapp.list_share
+where list_id = $1 and user_id = $2
+and (owner_id = $3 or user_id = $3)
+`
+
+type UnshareParams struct {
+	ListID   string
+	UserID   string
+	CallerID string
+}
+
+func (q *Queries) Unshare(ctx context.Context, arg UnshareParams) error {
+	_, err := q.db.Exec(ctx, unshare, arg.ListID, arg.UserID, arg.CallerID)
+	return err
+}
+
+const updateList = `-- name: UpdateList :one
+update todoapp.list
+set name = $1, updated_at = now()
+where user_id = $2 and list_id = $3
+returning id, user_id, list_id, name, created_at, updated_at
+`
+
+type UpdateListParams struct {
+	Name   string
+	UserID string
+	ListID string
+}
+
+func (q *Queries) UpdateList(ctx context.Context, arg UpdateListParams) (TodoappList, error) {
+	row := q.db.QueryRow(ctx, updateList, arg.Name, arg.UserID, arg.ListID)
+	var i TodoappList
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ListID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -39,10 +39,69 @@ const (
 	TodoAppServiceReadProcedure = "/todoapp.v1.TodoAppService/Read"
 	// TodoAppServiceReadAllProcedure is the fully-qualified name of the TodoAppService's ReadAll RPC.
 	TodoAppServiceReadAllProcedure = "/todoapp.v1.TodoAppService/ReadAll"
+	// TodoAppServiceListProcedure is the fully-qualified name of the TodoAppService's List RPC.
+	TodoAppServiceListProcedure = "/todoapp.v1.TodoAppService/List"
+	// TodoAppServiceSearchProcedure is the fully-qualified name of the TodoAppService's Search RPC.
+	TodoAppServiceSearchProcedure = "/todoapp.v1.TodoAppService/Search"
 	// TodoAppServiceUpdateProcedure is the fully-qualified name of the TodoAppService's Update RPC.
 	TodoAppServiceUpdateProcedure = "/todoapp.v1.TodoAppService/Update"
 	// TodoAppServiceDeleteProcedure is the fully-qualified name of the TodoAppService's Delete RPC.
 	TodoAppServiceDeleteProcedure = "/todoapp.v1.TodoAppService/Delete"
+	// TodoAppServiceBatchCreateProcedure is the fully-qualified name of the TodoAppService's
+	// BatchCreate RPC.
+	TodoAppServiceBatchCreateProcedure = "/todoapp.v1.TodoAppService/BatchCreate"
+	// TodoAppServiceBatchUpdateProcedure is the fully-qualified name of the TodoAppService's
+	// BatchUpdate RPC.
+	TodoAppServiceBatchUpdateProcedure = "/todoapp.v1.TodoAppService/BatchUpdate"
+	// TodoAppServiceBatchDeleteProcedure is the fully-qualified name of the TodoAppService's
+	// BatchDelete RPC.
+	TodoAppServiceBatchDeleteProcedure = "/todoapp.v1.TodoAppService/BatchDelete"
+	// TodoAppServiceListTrashProcedure is the fully-qualified name of the TodoAppService's ListTrash
+	// RPC.
+	TodoAppServiceListTrashProcedure = "/todoapp.v1.TodoAppService/ListTrash"
+	// TodoAppServiceRestoreProcedure is the fully-qualified name of the TodoAppService's Restore RPC.
+	TodoAppServiceRestoreProcedure = "/todoapp.v1.TodoAppService/Restore"
+	// TodoAppServicePurgeTrashProcedure is the fully-qualified name of the TodoAppService's PurgeTrash
+	// RPC.
+	TodoAppServicePurgeTrashProcedure = "/todoapp.v1.TodoAppService/PurgeTrash"
+	// TodoAppServiceCompleteProcedure is the fully-qualified name of the TodoAppService's Complete RPC.
+	TodoAppServiceCompleteProcedure = "/todoapp.v1.TodoAppService/Complete"
+	// TodoAppServiceReopenProcedure is the fully-qualified name of the TodoAppService's Reopen RPC.
+	TodoAppServiceReopenProcedure = "/todoapp.v1.TodoAppService/Reopen"
+	// TodoAppServiceAddTagsProcedure is the fully-qualified name of the TodoAppService's AddTags RPC.
+	TodoAppServiceAddTagsProcedure = "/todoapp.v1.TodoAppService/AddTags"
+	// TodoAppServiceRemoveTagsProcedure is the fully-qualified name of the TodoAppService's RemoveTags
+	// RPC.
+	TodoAppServiceRemoveTagsProcedure = "/todoapp.v1.TodoAppService/RemoveTags"
+	// TodoAppServiceListTagsProcedure is the fully-qualified name of the TodoAppService's ListTags RPC.
+	TodoAppServiceListTagsProcedure = "/todoapp.v1.TodoAppService/ListTags"
+	// TodoAppServiceMoveToListProcedure is the fully-qualified name of the TodoAppService's MoveToList
+	// RPC.
+	TodoAppServiceMoveToListProcedure = "/todoapp.v1.TodoAppService/MoveToList"
+	// TodoAppServiceWatchProcedure is the fully-qualified name of the TodoAppService's Watch RPC.
+	TodoAppServiceWatchProcedure = "/todoapp.v1.TodoAppService/Watch"
+	// TodoAppServiceSyncProcedure is the fully-qualified name of the TodoAppService's Sync RPC.
+	TodoAppServiceSyncProcedure = "/todoapp.v1.TodoAppService/Sync"
+	// TodoAppServiceListRevisionsProcedure is the fully-qualified name of the TodoAppService's
+	// ListRevisions RPC.
+	TodoAppServiceListRevisionsProcedure = "/todoapp.v1.TodoAppService/ListRevisions"
+	// TodoAppServiceRestoreRevisionProcedure is the fully-qualified name of the TodoAppService's
+	// RestoreRevision RPC.
+	TodoAppServiceRestoreRevisionProcedure = "/todoapp.v1.TodoAppService/RestoreRevision"
+	// TodoAppServiceUndoProcedure is the fully-qualified name of the TodoAppService's Undo RPC.
+	TodoAppServiceUndoProcedure = "/todoapp.v1.TodoAppService/Undo"
+	// TodoAppServiceReadTreeProcedure is the fully-qualified name of the TodoAppService's ReadTree RPC.
+	TodoAppServiceReadTreeProcedure = "/todoapp.v1.TodoAppService/ReadTree"
+	// TodoAppServiceMoveProcedure is the fully-qualified name of the TodoAppService's Move RPC.
+	TodoAppServiceMoveProcedure = "/todoapp.v1.TodoAppService/Move"
+	// TodoAppServiceAssignProcedure is the fully-qualified name of the TodoAppService's Assign RPC.
+	TodoAppServiceAssignProcedure = "/todoapp.v1.TodoAppService/Assign"
+	// TodoAppServiceListAssignedProcedure is the fully-qualified name of the TodoAppService's
+	// ListAssigned RPC.
+	TodoAppServiceListAssignedProcedure = "/todoapp.v1.TodoAppService/ListAssigned"
+	// TodoAppServiceListAssignmentsProcedure is the fully-qualified name of the TodoAppService's
+	// ListAssignments RPC.
+	TodoAppServiceListAssignmentsProcedure = "/todoapp.v1.TodoAppService/ListAssignments"
 )
 
 // TodoAppServiceClient is a client for the todoapp.v1.TodoAppService service.
@@ -50,8 +109,32 @@ type TodoAppServiceClient interface {
 	Create(context.Context, *connect_go.Request[v1.CreateRequest]) (*connect_go.Response[v1.CreateResponse], error)
 	Read(context.Context, *connect_go.Request[v1.ReadRequest]) (*connect_go.Response[v1.ReadResponse], error)
 	ReadAll(context.Context, *connect_go.Request[v1.ReadAllRequest]) (*connect_go.Response[v1.ReadAllResponse], error)
+	List(context.Context, *connect_go.Request[v1.ListRequest]) (*connect_go.Response[v1.ListResponse], error)
+	Search(context.Context, *connect_go.Request[v1.SearchRequest]) (*connect_go.Response[v1.SearchResponse], error)
 	Update(context.Context, *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error)
 	Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error)
+	BatchCreate(context.Context, *connect_go.Request[v1.BatchCreateRequest]) (*connect_go.Response[v1.BatchCreateResponse], error)
+	BatchUpdate(context.Context, *connect_go.Request[v1.BatchUpdateRequest]) (*connect_go.Response[v1.BatchUpdateResponse], error)
+	BatchDelete(context.Context, *connect_go.Request[v1.BatchDeleteRequest]) (*connect_go.Response[v1.BatchDeleteResponse], error)
+	ListTrash(context.Context, *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error)
+	Restore(context.Context, *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error)
+	PurgeTrash(context.Context, *connect_go.Request[v1.PurgeTrashRequest]) (*connect_go.Response[v1.PurgeTrashResponse], error)
+	Complete(context.Context, *connect_go.Request[v1.CompleteRequest]) (*connect_go.Response[v1.CompleteResponse], error)
+	Reopen(context.Context, *connect_go.Request[v1.ReopenRequest]) (*connect_go.Response[v1.ReopenResponse], error)
+	AddTags(context.Context, *connect_go.Request[v1.AddTagsRequest]) (*connect_go.Response[v1.AddTagsResponse], error)
+	RemoveTags(context.Context, *connect_go.Request[v1.RemoveTagsRequest]) (*connect_go.Response[v1.RemoveTagsResponse], error)
+	ListTags(context.Context, *connect_go.Request[v1.ListTagsRequest]) (*connect_go.Response[v1.ListTagsResponse], error)
+	MoveToList(context.Context, *connect_go.Request[v1.MoveToListRequest]) (*connect_go.Response[v1.MoveToListResponse], error)
+	Watch(context.Context, *connect_go.Request[v1.WatchRequest]) (*connect_go.ServerStreamForClient[v1.WatchResponse], error)
+	Sync(context.Context, *connect_go.Request[v1.SyncRequest]) (*connect_go.Response[v1.SyncResponse], error)
+	ListRevisions(context.Context, *connect_go.Request[v1.ListRevisionsRequest]) (*connect_go.Response[v1.ListRevisionsResponse], error)
+	RestoreRevision(context.Context, *connect_go.Request[v1.RestoreRevisionRequest]) (*connect_go.Response[v1.RestoreRevisionResponse], error)
+	Undo(context.Context, *connect_go.Request[v1.UndoRequest]) (*connect_go.Response[v1.UndoResponse], error)
+	ReadTree(context.Context, *connect_go.Request[v1.ReadTreeRequest]) (*connect_go.Response[v1.ReadTreeResponse], error)
+	Move(context.Context, *connect_go.Request[v1.MoveRequest]) (*connect_go.Response[v1.MoveResponse], error)
+	Assign(context.Context, *connect_go.Request[v1.AssignRequest]) (*connect_go.Response[v1.AssignResponse], error)
+	ListAssigned(context.Context, *connect_go.Request[v1.ListAssignedRequest]) (*connect_go.Response[v1.ListAssignedResponse], error)
+	ListAssignments(context.Context, *connect_go.Request[v1.ListAssignmentsRequest]) (*connect_go.Response[v1.ListAssignmentsResponse], error)
 }
 
 // NewTodoAppServiceClient constructs a client for the todoapp.v1.TodoAppService service. By
@@ -79,6 +162,16 @@ func NewTodoAppServiceClient(httpClient connect_go.HTTPClient, baseURL string, o
 			baseURL+TodoAppServiceReadAllProcedure,
 			opts...,
 		),
+		list: connect_go.NewClient[v1.ListRequest, v1.ListResponse](
+			httpClient,
+			baseURL+TodoAppServiceListProcedure,
+			opts...,
+		),
+		search: connect_go.NewClient[v1.SearchRequest, v1.SearchResponse](
+			httpClient,
+			baseURL+TodoAppServiceSearchProcedure,
+			opts...,
+		),
 		update: connect_go.NewClient[v1.UpdateRequest, v1.UpdateResponse](
 			httpClient,
 			baseURL+TodoAppServiceUpdateProcedure,
@@ -89,16 +182,150 @@ func NewTodoAppServiceClient(httpClient connect_go.HTTPClient, baseURL string, o
 			baseURL+TodoAppServiceDeleteProcedure,
 			opts...,
 		),
+		batchCreate: connect_go.NewClient[v1.BatchCreateRequest, v1.BatchCreateResponse](
+			httpClient,
+			baseURL+TodoAppServiceBatchCreateProcedure,
+			opts...,
+		),
+		batchUpdate: connect_go.NewClient[v1.BatchUpdateRequest, v1.BatchUpdateResponse](
+			httpClient,
+			baseURL+TodoAppServiceBatchUpdateProcedure,
+			opts...,
+		),
+		batchDelete: connect_go.NewClient[v1.BatchDeleteRequest, v1.BatchDeleteResponse](
+			httpClient,
+			baseURL+TodoAppServiceBatchDeleteProcedure,
+			opts...,
+		),
+		listTrash: connect_go.NewClient[v1.ListTrashRequest, v1.ListTrashResponse](
+			httpClient,
+			baseURL+TodoAppServiceListTrashProcedure,
+			opts...,
+		),
+		restore: connect_go.NewClient[v1.RestoreRequest, v1.RestoreResponse](
+			httpClient,
+			baseURL+TodoAppServiceRestoreProcedure,
+			opts...,
+		),
+		purgeTrash: connect_go.NewClient[v1.PurgeTrashRequest, v1.PurgeTrashResponse](
+			httpClient,
+			baseURL+TodoAppServicePurgeTrashProcedure,
+			opts...,
+		),
+		complete: connect_go.NewClient[v1.CompleteRequest, v1.CompleteResponse](
+			httpClient,
+			baseURL+TodoAppServiceCompleteProcedure,
+			opts...,
+		),
+		reopen: connect_go.NewClient[v1.ReopenRequest, v1.ReopenResponse](
+			httpClient,
+			baseURL+TodoAppServiceReopenProcedure,
+			opts...,
+		),
+		addTags: connect_go.NewClient[v1.AddTagsRequest, v1.AddTagsResponse](
+			httpClient,
+			baseURL+TodoAppServiceAddTagsProcedure,
+			opts...,
+		),
+		removeTags: connect_go.NewClient[v1.RemoveTagsRequest, v1.RemoveTagsResponse](
+			httpClient,
+			baseURL+TodoAppServiceRemoveTagsProcedure,
+			opts...,
+		),
+		listTags: connect_go.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+TodoAppServiceListTagsProcedure,
+			opts...,
+		),
+		moveToList: connect_go.NewClient[v1.MoveToListRequest, v1.MoveToListResponse](
+			httpClient,
+			baseURL+TodoAppServiceMoveToListProcedure,
+			opts...,
+		),
+		watch: connect_go.NewClient[v1.WatchRequest, v1.WatchResponse](
+			httpClient,
+			baseURL+TodoAppServiceWatchProcedure,
+			opts...,
+		),
+		sync: connect_go.NewClient[v1.SyncRequest, v1.SyncResponse](
+			httpClient,
+			baseURL+TodoAppServiceSyncProcedure,
+			opts...,
+		),
+		listRevisions: connect_go.NewClient[v1.ListRevisionsRequest, v1.ListRevisionsResponse](
+			httpClient,
+			baseURL+TodoAppServiceListRevisionsProcedure,
+			opts...,
+		),
+		restoreRevision: connect_go.NewClient[v1.RestoreRevisionRequest, v1.RestoreRevisionResponse](
+			httpClient,
+			baseURL+TodoAppServiceRestoreRevisionProcedure,
+			opts...,
+		),
+		undo: connect_go.NewClient[v1.UndoRequest, v1.UndoResponse](
+			httpClient,
+			baseURL+TodoAppServiceUndoProcedure,
+			opts...,
+		),
+		readTree: connect_go.NewClient[v1.ReadTreeRequest, v1.ReadTreeResponse](
+			httpClient,
+			baseURL+TodoAppServiceReadTreeProcedure,
+			opts...,
+		),
+		move: connect_go.NewClient[v1.MoveRequest, v1.MoveResponse](
+			httpClient,
+			baseURL+TodoAppServiceMoveProcedure,
+			opts...,
+		),
+		assign: connect_go.NewClient[v1.AssignRequest, v1.AssignResponse](
+			httpClient,
+			baseURL+TodoAppServiceAssignProcedure,
+			opts...,
+		),
+		listAssigned: connect_go.NewClient[v1.ListAssignedRequest, v1.ListAssignedResponse](
+			httpClient,
+			baseURL+TodoAppServiceListAssignedProcedure,
+			opts...,
+		),
+		listAssignments: connect_go.NewClient[v1.ListAssignmentsRequest, v1.ListAssignmentsResponse](
+			httpClient,
+			baseURL+TodoAppServiceListAssignmentsProcedure,
+			opts...,
+		),
 	}
 }
 
 // todoAppServiceClient implements TodoAppServiceClient.
 type todoAppServiceClient struct {
-	create  *connect_go.Client[v1.CreateRequest, v1.CreateResponse]
-	read    *connect_go.Client[v1.ReadRequest, v1.ReadResponse]
-	readAll *connect_go.Client[v1.ReadAllRequest, v1.ReadAllResponse]
-	update  *connect_go.Client[v1.UpdateRequest, v1.UpdateResponse]
-	delete  *connect_go.Client[v1.DeleteRequest, v1.DeleteResponse]
+	create          *connect_go.Client[v1.CreateRequest, v1.CreateResponse]
+	read            *connect_go.Client[v1.ReadRequest, v1.ReadResponse]
+	readAll         *connect_go.Client[v1.ReadAllRequest, v1.ReadAllResponse]
+	list            *connect_go.Client[v1.ListRequest, v1.ListResponse]
+	search          *connect_go.Client[v1.SearchRequest, v1.SearchResponse]
+	update          *connect_go.Client[v1.UpdateRequest, v1.UpdateResponse]
+	delete          *connect_go.Client[v1.DeleteRequest, v1.DeleteResponse]
+	batchCreate     *connect_go.Client[v1.BatchCreateRequest, v1.BatchCreateResponse]
+	batchUpdate     *connect_go.Client[v1.BatchUpdateRequest, v1.BatchUpdateResponse]
+	batchDelete     *connect_go.Client[v1.BatchDeleteRequest, v1.BatchDeleteResponse]
+	listTrash       *connect_go.Client[v1.ListTrashRequest, v1.ListTrashResponse]
+	restore         *connect_go.Client[v1.RestoreRequest, v1.RestoreResponse]
+	purgeTrash      *connect_go.Client[v1.PurgeTrashRequest, v1.PurgeTrashResponse]
+	complete        *connect_go.Client[v1.CompleteRequest, v1.CompleteResponse]
+	reopen          *connect_go.Client[v1.ReopenRequest, v1.ReopenResponse]
+	addTags         *connect_go.Client[v1.AddTagsRequest, v1.AddTagsResponse]
+	removeTags      *connect_go.Client[v1.RemoveTagsRequest, v1.RemoveTagsResponse]
+	listTags        *connect_go.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+	moveToList      *connect_go.Client[v1.MoveToListRequest, v1.MoveToListResponse]
+	watch           *connect_go.Client[v1.WatchRequest, v1.WatchResponse]
+	sync            *connect_go.Client[v1.SyncRequest, v1.SyncResponse]
+	listRevisions   *connect_go.Client[v1.ListRevisionsRequest, v1.ListRevisionsResponse]
+	restoreRevision *connect_go.Client[v1.RestoreRevisionRequest, v1.RestoreRevisionResponse]
+	undo            *connect_go.Client[v1.UndoRequest, v1.UndoResponse]
+	readTree        *connect_go.Client[v1.ReadTreeRequest, v1.ReadTreeResponse]
+	move            *connect_go.Client[v1.MoveRequest, v1.MoveResponse]
+	assign          *connect_go.Client[v1.AssignRequest, v1.AssignResponse]
+	listAssigned    *connect_go.Client[v1.ListAssignedRequest, v1.ListAssignedResponse]
+	listAssignments *connect_go.Client[v1.ListAssignmentsRequest, v1.ListAssignmentsResponse]
 }
 
 // Create calls todoapp.v1.TodoAppService.Create.
@@ -116,6 +343,16 @@ func (c *todoAppServiceClient) ReadAll(ctx context.Context, req *connect_go.Requ
 	return c.readAll.CallUnary(ctx, req)
 }
 
+// List calls todoapp.v1.TodoAppService.List.
+func (c *todoAppServiceClient) List(ctx context.Context, req *connect_go.Request[v1.ListRequest]) (*connect_go.Response[v1.ListResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// Search calls todoapp.v1.TodoAppService.Search.
+func (c *todoAppServiceClient) Search(ctx context.Context, req *connect_go.Request[v1.SearchRequest]) (*connect_go.Response[v1.SearchResponse], error) {
+	return c.search.CallUnary(ctx, req)
+}
+
 // Update calls todoapp.v1.TodoAppService.Update.
 func (c *todoAppServiceClient) Update(ctx context.Context, req *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
@@ -126,13 +363,147 @@ func (c *todoAppServiceClient) Delete(ctx context.Context, req *connect_go.Reque
 	return c.delete.CallUnary(ctx, req)
 }
 
+// BatchCreate calls todoapp.v1.TodoAppService.BatchCreate.
+func (c *todoAppServiceClient) BatchCreate(ctx context.Context, req *connect_go.Request[v1.BatchCreateRequest]) (*connect_go.Response[v1.BatchCreateResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// BatchUpdate calls todoapp.v1.TodoAppService.BatchUpdate.
+func (c *todoAppServiceClient) BatchUpdate(ctx context.Context, req *connect_go.Request[v1.BatchUpdateRequest]) (*connect_go.Response[v1.BatchUpdateResponse], error) {
+	return c.batchUpdate.CallUnary(ctx, req)
+}
+
+// BatchDelete calls todoapp.v1.TodoAppService.BatchDelete.
+func (c *todoAppServiceClient) BatchDelete(ctx context.Context, req *connect_go.Request[v1.BatchDeleteRequest]) (*connect_go.Response[v1.BatchDeleteResponse], error) {
+	return c.batchDelete.CallUnary(ctx, req)
+}
+
+// ListTrash calls todoapp.v1.TodoAppService.ListTrash.
+func (c *todoAppServiceClient) ListTrash(ctx context.Context, req *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error) {
+	return c.listTrash.CallUnary(ctx, req)
+}
+
+// Restore calls todoapp.v1.TodoAppService.Restore.
+func (c *todoAppServiceClient) Restore(ctx context.Context, req *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error) {
+	return c.restore.CallUnary(ctx, req)
+}
+
+// PurgeTrash calls todoapp.v1.TodoAppService.PurgeTrash.
+func (c *todoAppServiceClient) PurgeTrash(ctx context.Context, req *connect_go.Request[v1.PurgeTrashRequest]) (*connect_go.Response[v1.PurgeTrashResponse], error) {
+	return c.purgeTrash.CallUnary(ctx, req)
+}
+
+// Complete calls todoapp.v1.TodoAppService.Complete.
+func (c *todoAppServiceClient) Complete(ctx context.Context, req *connect_go.Request[v1.CompleteRequest]) (*connect_go.Response[v1.CompleteResponse], error) {
+	return c.complete.CallUnary(ctx, req)
+}
+
+// Reopen calls todoapp.v1.TodoAppService.Reopen.
+func (c *todoAppServiceClient) Reopen(ctx context.Context, req *connect_go.Request[v1.ReopenRequest]) (*connect_go.Response[v1.ReopenResponse], error) {
+	return c.reopen.CallUnary(ctx, req)
+}
+
+// AddTags calls todoapp.v1.TodoAppService.AddTags.
+func (c *todoAppServiceClient) AddTags(ctx context.Context, req *connect_go.Request[v1.AddTagsRequest]) (*connect_go.Response[v1.AddTagsResponse], error) {
+	return c.addTags.CallUnary(ctx, req)
+}
+
+// RemoveTags calls todoapp.v1.TodoAppService.RemoveTags.
+func (c *todoAppServiceClient) RemoveTags(ctx context.Context, req *connect_go.Request[v1.RemoveTagsRequest]) (*connect_go.Response[v1.RemoveTagsResponse], error) {
+	return c.removeTags.CallUnary(ctx, req)
+}
+
+// ListTags calls todoapp.v1.TodoAppService.ListTags.
+func (c *todoAppServiceClient) ListTags(ctx context.Context, req *connect_go.Request[v1.ListTagsRequest]) (*connect_go.Response[v1.ListTagsResponse], error) {
+	return c.listTags.CallUnary(ctx, req)
+}
+
+// MoveToList calls todoapp.v1.TodoAppService.MoveToList.
+func (c *todoAppServiceClient) MoveToList(ctx context.Context, req *connect_go.Request[v1.MoveToListRequest]) (*connect_go.Response[v1.MoveToListResponse], error) {
+	return c.moveToList.CallUnary(ctx, req)
+}
+
+// Watch calls todoapp.v1.TodoAppService.Watch.
+func (c *todoAppServiceClient) Watch(ctx context.Context, req *connect_go.Request[v1.WatchRequest]) (*connect_go.ServerStreamForClient[v1.WatchResponse], error) {
+	return c.watch.CallServerStream(ctx, req)
+}
+
+// Sync calls todoapp.v1.TodoAppService.Sync.
+func (c *todoAppServiceClient) Sync(ctx context.Context, req *connect_go.Request[v1.SyncRequest]) (*connect_go.Response[v1.SyncResponse], error) {
+	return c.sync.CallUnary(ctx, req)
+}
+
+// ListRevisions calls todoapp.v1.TodoAppService.ListRevisions.
+func (c *todoAppServiceClient) ListRevisions(ctx context.Context, req *connect_go.Request[v1.ListRevisionsRequest]) (*connect_go.Response[v1.ListRevisionsResponse], error) {
+	return c.listRevisions.CallUnary(ctx, req)
+}
+
+// RestoreRevision calls todoapp.v1.TodoAppService.RestoreRevision.
+func (c *todoAppServiceClient) RestoreRevision(ctx context.Context, req *connect_go.Request[v1.RestoreRevisionRequest]) (*connect_go.Response[v1.RestoreRevisionResponse], error) {
+	return c.restoreRevision.CallUnary(ctx, req)
+}
+
+// Undo calls todoapp.v1.TodoAppService.Undo.
+func (c *todoAppServiceClient) Undo(ctx context.Context, req *connect_go.Request[v1.UndoRequest]) (*connect_go.Response[v1.UndoResponse], error) {
+	return c.undo.CallUnary(ctx, req)
+}
+
+// ReadTree calls todoapp.v1.TodoAppService.ReadTree.
+func (c *todoAppServiceClient) ReadTree(ctx context.Context, req *connect_go.Request[v1.ReadTreeRequest]) (*connect_go.Response[v1.ReadTreeResponse], error) {
+	return c.readTree.CallUnary(ctx, req)
+}
+
+// Move calls todoapp.v1.TodoAppService.Move.
+func (c *todoAppServiceClient) Move(ctx context.Context, req *connect_go.Request[v1.MoveRequest]) (*connect_go.Response[v1.MoveResponse], error) {
+	return c.move.CallUnary(ctx, req)
+}
+
+// Assign calls todoapp.v1.TodoAppService.Assign.
+func (c *todoAppServiceClient) Assign(ctx context.Context, req *connect_go.Request[v1.AssignRequest]) (*connect_go.Response[v1.AssignResponse], error) {
+	return c.assign.CallUnary(ctx, req)
+}
+
+// ListAssigned calls todoapp.v1.TodoAppService.ListAssigned.
+func (c *todoAppServiceClient) ListAssigned(ctx context.Context, req *connect_go.Request[v1.ListAssignedRequest]) (*connect_go.Response[v1.ListAssignedResponse], error) {
+	return c.listAssigned.CallUnary(ctx, req)
+}
+
+// ListAssignments calls todoapp.v1.TodoAppService.ListAssignments.
+func (c *todoAppServiceClient) ListAssignments(ctx context.Context, req *connect_go.Request[v1.ListAssignmentsRequest]) (*connect_go.Response[v1.ListAssignmentsResponse], error) {
+	return c.listAssignments.CallUnary(ctx, req)
+}
+
 // TodoAppServiceHandler is an implementation of the todoapp.v1.TodoAppService service.
 type TodoAppServiceHandler interface {
 	Create(context.Context, *connect_go.Request[v1.CreateRequest]) (*connect_go.Response[v1.CreateResponse], error)
 	Read(context.Context, *connect_go.Request[v1.ReadRequest]) (*connect_go.Response[v1.ReadResponse], error)
 	ReadAll(context.Context, *connect_go.Request[v1.ReadAllRequest]) (*connect_go.Response[v1.ReadAllResponse], error)
+	List(context.Context, *connect_go.Request[v1.ListRequest]) (*connect_go.Response[v1.ListResponse], error)
+	Search(context.Context, *connect_go.Request[v1.SearchRequest]) (*connect_go.Response[v1.SearchResponse], error)
 	Update(context.Context, *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error)
 	Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error)
+	BatchCreate(context.Context, *connect_go.Request[v1.BatchCreateRequest]) (*connect_go.Response[v1.BatchCreateResponse], error)
+	BatchUpdate(context.Context, *connect_go.Request[v1.BatchUpdateRequest]) (*connect_go.Response[v1.BatchUpdateResponse], error)
+	BatchDelete(context.Context, *connect_go.Request[v1.BatchDeleteRequest]) (*connect_go.Response[v1.BatchDeleteResponse], error)
+	ListTrash(context.Context, *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error)
+	Restore(context.Context, *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error)
+	PurgeTrash(context.Context, *connect_go.Request[v1.PurgeTrashRequest]) (*connect_go.Response[v1.PurgeTrashResponse], error)
+	Complete(context.Context, *connect_go.Request[v1.CompleteRequest]) (*connect_go.Response[v1.CompleteResponse], error)
+	Reopen(context.Context, *connect_go.Request[v1.ReopenRequest]) (*connect_go.Response[v1.ReopenResponse], error)
+	AddTags(context.Context, *connect_go.Request[v1.AddTagsRequest]) (*connect_go.Response[v1.AddTagsResponse], error)
+	RemoveTags(context.Context, *connect_go.Request[v1.RemoveTagsRequest]) (*connect_go.Response[v1.RemoveTagsResponse], error)
+	ListTags(context.Context, *connect_go.Request[v1.ListTagsRequest]) (*connect_go.Response[v1.ListTagsResponse], error)
+	MoveToList(context.Context, *connect_go.Request[v1.MoveToListRequest]) (*connect_go.Response[v1.MoveToListResponse], error)
+	Watch(context.Context, *connect_go.Request[v1.WatchRequest], *connect_go.ServerStream[v1.WatchResponse]) error
+	Sync(context.Context, *connect_go.Request[v1.SyncRequest]) (*connect_go.Response[v1.SyncResponse], error)
+	ListRevisions(context.Context, *connect_go.Request[v1.ListRevisionsRequest]) (*connect_go.Response[v1.ListRevisionsResponse], error)
+	RestoreRevision(context.Context, *connect_go.Request[v1.RestoreRevisionRequest]) (*connect_go.Response[v1.RestoreRevisionResponse], error)
+	Undo(context.Context, *connect_go.Request[v1.UndoRequest]) (*connect_go.Response[v1.UndoResponse], error)
+	ReadTree(context.Context, *connect_go.Request[v1.ReadTreeRequest]) (*connect_go.Response[v1.ReadTreeResponse], error)
+	Move(context.Context, *connect_go.Request[v1.MoveRequest]) (*connect_go.Response[v1.MoveResponse], error)
+	Assign(context.Context, *connect_go.Request[v1.AssignRequest]) (*connect_go.Response[v1.AssignResponse], error)
+	ListAssigned(context.Context, *connect_go.Request[v1.ListAssignedRequest]) (*connect_go.Response[v1.ListAssignedResponse], error)
+	ListAssignments(context.Context, *connect_go.Request[v1.ListAssignmentsRequest]) (*connect_go.Response[v1.ListAssignmentsResponse], error)
 }
 
 // NewTodoAppServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -156,6 +527,16 @@ func NewTodoAppServiceHandler(svc TodoAppServiceHandler, opts ...connect_go.Hand
 		svc.ReadAll,
 		opts...,
 	)
+	todoAppServiceListHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceListProcedure,
+		svc.List,
+		opts...,
+	)
+	todoAppServiceSearchHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceSearchProcedure,
+		svc.Search,
+		opts...,
+	)
 	todoAppServiceUpdateHandler := connect_go.NewUnaryHandler(
 		TodoAppServiceUpdateProcedure,
 		svc.Update,
@@ -166,6 +547,116 @@ func NewTodoAppServiceHandler(svc TodoAppServiceHandler, opts ...connect_go.Hand
 		svc.Delete,
 		opts...,
 	)
+	todoAppServiceBatchCreateHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		opts...,
+	)
+	todoAppServiceBatchUpdateHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceBatchUpdateProcedure,
+		svc.BatchUpdate,
+		opts...,
+	)
+	todoAppServiceBatchDeleteHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceBatchDeleteProcedure,
+		svc.BatchDelete,
+		opts...,
+	)
+	todoAppServiceListTrashHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceListTrashProcedure,
+		svc.ListTrash,
+		opts...,
+	)
+	todoAppServiceRestoreHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceRestoreProcedure,
+		svc.Restore,
+		opts...,
+	)
+	todoAppServicePurgeTrashHandler := connect_go.NewUnaryHandler(
+		TodoAppServicePurgeTrashProcedure,
+		svc.PurgeTrash,
+		opts...,
+	)
+	todoAppServiceCompleteHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceCompleteProcedure,
+		svc.Complete,
+		opts...,
+	)
+	todoAppServiceReopenHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceReopenProcedure,
+		svc.Reopen,
+		opts...,
+	)
+	todoAppServiceAddTagsHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceAddTagsProcedure,
+		svc.AddTags,
+		opts...,
+	)
+	todoAppServiceRemoveTagsHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceRemoveTagsProcedure,
+		svc.RemoveTags,
+		opts...,
+	)
+	todoAppServiceListTagsHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceListTagsProcedure,
+		svc.ListTags,
+		opts...,
+	)
+	todoAppServiceMoveToListHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceMoveToListProcedure,
+		svc.MoveToList,
+		opts...,
+	)
+	todoAppServiceWatchHandler := connect_go.NewServerStreamHandler(
+		TodoAppServiceWatchProcedure,
+		svc.Watch,
+		opts...,
+	)
+	todoAppServiceSyncHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceSyncProcedure,
+		svc.Sync,
+		opts...,
+	)
+	todoAppServiceListRevisionsHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceListRevisionsProcedure,
+		svc.ListRevisions,
+		opts...,
+	)
+	todoAppServiceRestoreRevisionHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceRestoreRevisionProcedure,
+		svc.RestoreRevision,
+		opts...,
+	)
+	todoAppServiceUndoHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceUndoProcedure,
+		svc.Undo,
+		opts...,
+	)
+	todoAppServiceReadTreeHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceReadTreeProcedure,
+		svc.ReadTree,
+		opts...,
+	)
+	todoAppServiceMoveHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceMoveProcedure,
+		svc.Move,
+		opts...,
+	)
+	todoAppServiceAssignHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceAssignProcedure,
+		svc.Assign,
+		opts...,
+	)
+	todoAppServiceListAssignedHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceListAssignedProcedure,
+		svc.ListAssigned,
+		opts...,
+	)
+	todoAppServiceListAssignmentsHandler := connect_go.NewUnaryHandler(
+		TodoAppServiceListAssignmentsProcedure,
+		svc.ListAssignments,
+		opts...,
+	)
 	return "/todoapp.v1.TodoAppService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TodoAppServiceCreateProcedure:
@@ -174,10 +665,58 @@ func NewTodoAppServiceHandler(svc TodoAppServiceHandler, opts ...connect_go.Hand
 			todoAppServiceReadHandler.ServeHTTP(w, r)
 		case TodoAppServiceReadAllProcedure:
 			todoAppServiceReadAllHandler.ServeHTTP(w, r)
+		case TodoAppServiceListProcedure:
+			todoAppServiceListHandler.ServeHTTP(w, r)
+		case TodoAppServiceSearchProcedure:
+			todoAppServiceSearchHandler.ServeHTTP(w, r)
 		case TodoAppServiceUpdateProcedure:
 			todoAppServiceUpdateHandler.ServeHTTP(w, r)
 		case TodoAppServiceDeleteProcedure:
 			todoAppServiceDeleteHandler.ServeHTTP(w, r)
+		case TodoAppServiceBatchCreateProcedure:
+			todoAppServiceBatchCreateHandler.ServeHTTP(w, r)
+		case TodoAppServiceBatchUpdateProcedure:
+			todoAppServiceBatchUpdateHandler.ServeHTTP(w, r)
+		case TodoAppServiceBatchDeleteProcedure:
+			todoAppServiceBatchDeleteHandler.ServeHTTP(w, r)
+		case TodoAppServiceListTrashProcedure:
+			todoAppServiceListTrashHandler.ServeHTTP(w, r)
+		case TodoAppServiceRestoreProcedure:
+			todoAppServiceRestoreHandler.ServeHTTP(w, r)
+		case TodoAppServicePurgeTrashProcedure:
+			todoAppServicePurgeTrashHandler.ServeHTTP(w, r)
+		case TodoAppServiceCompleteProcedure:
+			todoAppServiceCompleteHandler.ServeHTTP(w, r)
+		case TodoAppServiceReopenProcedure:
+			todoAppServiceReopenHandler.ServeHTTP(w, r)
+		case TodoAppServiceAddTagsProcedure:
+			todoAppServiceAddTagsHandler.ServeHTTP(w, r)
+		case TodoAppServiceRemoveTagsProcedure:
+			todoAppServiceRemoveTagsHandler.ServeHTTP(w, r)
+		case TodoAppServiceListTagsProcedure:
+			todoAppServiceListTagsHandler.ServeHTTP(w, r)
+		case TodoAppServiceMoveToListProcedure:
+			todoAppServiceMoveToListHandler.ServeHTTP(w, r)
+		case TodoAppServiceWatchProcedure:
+			todoAppServiceWatchHandler.ServeHTTP(w, r)
+		case TodoAppServiceSyncProcedure:
+			todoAppServiceSyncHandler.ServeHTTP(w, r)
+		case TodoAppServiceListRevisionsProcedure:
+			todoAppServiceListRevisionsHandler.ServeHTTP(w, r)
+		case TodoAppServiceRestoreRevisionProcedure:
+			todoAppServiceRestoreRevisionHandler.ServeHTTP(w, r)
+		case TodoAppServiceUndoProcedure:
+			todoAppServiceUndoHandler.ServeHTTP(w, r)
+		case TodoAppServiceReadTreeProcedure:
+			todoAppServiceReadTreeHandler.ServeHTTP(w, r)
+		case TodoAppServiceMoveProcedure:
+			todoAppServiceMoveHandler.ServeHTTP(w, r)
+		case TodoAppServiceAssignProcedure:
+			todoAppServiceAssignHandler.ServeHTTP(w, r)
+		case TodoAppServiceListAssignedProcedure:
+			todoAppServiceListAssignedHandler.ServeHTTP(w, r)
+		case TodoAppServiceListAssignmentsProcedure:
+			todoAppServiceListAssignmentsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -199,6 +738,14 @@ func (UnimplementedTodoAppServiceHandler) ReadAll(context.Context, *connect_go.R
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.ReadAll is not implemented"))
 }
 
+func (UnimplementedTodoAppServiceHandler) List(context.Context, *connect_go.Request[v1.ListRequest]) (*connect_go.Response[v1.ListResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.List is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Search(context.Context, *connect_go.Request[v1.SearchRequest]) (*connect_go.Response[v1.SearchResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Search is not implemented"))
+}
+
 func (UnimplementedTodoAppServiceHandler) Update(context.Context, *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Update is not implemented"))
 }
@@ -206,3 +753,91 @@ func (UnimplementedTodoAppServiceHandler) Update(context.Context, *connect_go.Re
 func (UnimplementedTodoAppServiceHandler) Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Delete is not implemented"))
 }
+
+func (UnimplementedTodoAppServiceHandler) BatchCreate(context.Context, *connect_go.Request[v1.BatchCreateRequest]) (*connect_go.Response[v1.BatchCreateResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.BatchCreate is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) BatchUpdate(context.Context, *connect_go.Request[v1.BatchUpdateRequest]) (*connect_go.Response[v1.BatchUpdateResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.BatchUpdate is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) BatchDelete(context.Context, *connect_go.Request[v1.BatchDeleteRequest]) (*connect_go.Response[v1.BatchDeleteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.BatchDelete is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) ListTrash(context.Context, *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.ListTrash is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Restore(context.Context, *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Restore is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) PurgeTrash(context.Context, *connect_go.Request[v1.PurgeTrashRequest]) (*connect_go.Response[v1.PurgeTrashResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.PurgeTrash is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Complete(context.Context, *connect_go.Request[v1.CompleteRequest]) (*connect_go.Response[v1.CompleteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Complete is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Reopen(context.Context, *connect_go.Request[v1.ReopenRequest]) (*connect_go.Response[v1.ReopenResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Reopen is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) AddTags(context.Context, *connect_go.Request[v1.AddTagsRequest]) (*connect_go.Response[v1.AddTagsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.AddTags is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) RemoveTags(context.Context, *connect_go.Request[v1.RemoveTagsRequest]) (*connect_go.Response[v1.RemoveTagsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.RemoveTags is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) ListTags(context.Context, *connect_go.Request[v1.ListTagsRequest]) (*connect_go.Response[v1.ListTagsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.ListTags is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) MoveToList(context.Context, *connect_go.Request[v1.MoveToListRequest]) (*connect_go.Response[v1.MoveToListResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.MoveToList is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Watch(context.Context, *connect_go.Request[v1.WatchRequest], *connect_go.ServerStream[v1.WatchResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Watch is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Sync(context.Context, *connect_go.Request[v1.SyncRequest]) (*connect_go.Response[v1.SyncResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Sync is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) ListRevisions(context.Context, *connect_go.Request[v1.ListRevisionsRequest]) (*connect_go.Response[v1.ListRevisionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.ListRevisions is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) RestoreRevision(context.Context, *connect_go.Request[v1.RestoreRevisionRequest]) (*connect_go.Response[v1.RestoreRevisionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.RestoreRevision is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Undo(context.Context, *connect_go.Request[v1.UndoRequest]) (*connect_go.Response[v1.UndoResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Undo is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) ReadTree(context.Context, *connect_go.Request[v1.ReadTreeRequest]) (*connect_go.Response[v1.ReadTreeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.ReadTree is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Move(context.Context, *connect_go.Request[v1.MoveRequest]) (*connect_go.Response[v1.MoveResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Move is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) Assign(context.Context, *connect_go.Request[v1.AssignRequest]) (*connect_go.Response[v1.AssignResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.Assign is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) ListAssigned(context.Context, *connect_go.Request[v1.ListAssignedRequest]) (*connect_go.Response[v1.ListAssignedResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.ListAssigned is not implemented"))
+}
+
+func (UnimplementedTodoAppServiceHandler) ListAssignments(context.Context, *connect_go.Request[v1.ListAssignmentsRequest]) (*connect_go.Response[v1.ListAssignmentsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("todoapp.v1.TodoAppService.ListAssignments is not implemented"))
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"errors"
+	"net/http"
 	"strings"
 
 	"github.com/bufbuild/connect-go"
@@ -11,39 +12,101 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func NewAuthenticationInterceptor(pool *pgxpool.Pool, secret string) connect.UnaryInterceptorFunc {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			authHeader := strings.Split(req.Header().Get("Authentication"), "Bearer ")
-			if len(authHeader) != 2 {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					errors.New("malformed token"),
-				)
-			}
-
-			jwtToken := authHeader[1]
-			t, err := jwt.Parse(jwtToken, func(token *jwt.Token) (any, error) {
-				return []byte(secret), nil
-			})
-			if err != nil {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					errors.New("unauthenticated"),
-				)
-			}
-
-			sub, err := t.Claims.GetSubject()
-			if err != nil || sub == "" {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					errors.New("unauthenticated"),
-				)
-			}
-
-			ctx = ctxpkg.SetUserIDInCtx(ctx, sub)
+type authenticationInterceptor struct {
+	secret string
+	token  string
+}
+
+// NewAuthenticationInterceptor authenticates unary and streaming requests
+// with the JWT in the Authentication header, and puts its subject in the
+// context as the user id.
+func NewAuthenticationInterceptor(pool *pgxpool.Pool, secret string) connect.Interceptor {
+	return &authenticationInterceptor{secret: secret}
+}
 
+// NewClientAuthenticationInterceptor sends token in the Authentication header
+// of every unary and streaming request a client makes, unless the request
+// already has one.
+func NewClientAuthenticationInterceptor(token string) connect.Interceptor {
+	return &authenticationInterceptor{token: token}
+}
+
+func (i *authenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			i.setToken(req.Header())
 			return next(ctx, req)
-		})
+		}
+
+		sub, err := i.authenticate(req.Header())
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = ctxpkg.SetUserIDInCtx(ctx, sub)
+
+		return next(ctx, req)
+	})
+}
+
+func (i *authenticationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		i.setToken(conn.RequestHeader())
+		return conn
 	})
 }
+
+func (i *authenticationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		sub, err := i.authenticate(conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+
+		ctx = ctxpkg.SetUserIDInCtx(ctx, sub)
+
+		return next(ctx, conn)
+	})
+}
+
+// setToken adds the client's token to an outgoing request's header.
+func (i *authenticationInterceptor) setToken(header http.Header) {
+	if i.token == "" || header.Get("Authentication") != "" {
+		return
+	}
+
+	header.Set("Authentication", "Bearer "+i.token)
+}
+
+// authenticate returns the subject of the request's token.
+func (i *authenticationInterceptor) authenticate(header http.Header) (string, error) {
+	authHeader := strings.Split(header.Get("Authentication"), "Bearer ")
+	if len(authHeader) != 2 {
+		return "", connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("malformed token"),
+		)
+	}
+
+	jwtToken := authHeader[1]
+	t, err := jwt.Parse(jwtToken, func(token *jwt.Token) (any, error) {
+		return []byte(i.secret), nil
+	})
+	if err != nil {
+		return "", connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthenticated"),
+		)
+	}
+
+	sub, err := t.Claims.GetSubject()
+	if err != nil || sub == "" {
+		return "", connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("unauthenticated"),
+		)
+	}
+
+	return sub, nil
+}
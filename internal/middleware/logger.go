@@ -2,6 +2,9 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"io"
+	"sync/atomic"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -9,35 +12,167 @@ import (
 	"golang.org/x/exp/slog"
 )
 
-func NewLoggingInterceptor() connect.UnaryInterceptorFunc {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			start := time.Now()
+type loggingInterceptor struct{}
 
-			res, err := next(ctx, req)
+// NewLoggingInterceptor logs every unary request and, once it ends, every
+// stream, on both the client and the handler side.
+func NewLoggingInterceptor() connect.Interceptor {
+	return &loggingInterceptor{}
+}
+
+func (i *loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+
+		res, err := next(ctx, req)
+
+		fields := []any{
+			"procedure", req.Spec().Procedure,
+			"took", time.Since(start),
+			"req", req.Any(),
+		}
+
+		if err != nil {
+			fields = append(fields, errorFields(err)...)
+
+			slog.ErrorCtx(ctx, "req_error", fields...)
+			return nil, err
+		}
 
-			fields := []any{
-				"procedure", req.Spec().Procedure,
-				"took", time.Since(start),
-				"req", req.Any(),
-			}
+		fields = append(fields, "res", res.Any())
 
-			if err != nil {
-				fields = append(fields, "error", err.Error())
+		slog.InfoCtx(ctx, "req_complete", fields...)
 
-				if e, ok := err.(*server.ServerError); ok && e.Internal != nil {
-					fields = append(fields, "internal_error", e.Internal.Error())
-				}
+		return res, nil
+	})
+}
+
+func (i *loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return &loggingClientConn{
+			StreamingClientConn: next(ctx, spec),
+			ctx:                 ctx,
+			start:               time.Now(),
+		}
+	})
+}
 
-				slog.ErrorCtx(ctx, "req_error", fields...)
-				return nil, err
-			}
+func (i *loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		counting := &countingHandlerConn{StreamingHandlerConn: conn}
 
-			fields = append(fields, "res", res.Any())
+		err := next(ctx, counting)
 
-			slog.InfoCtx(ctx, "req_complete", fields...)
+		logStream(ctx, conn.Spec(), start, counting.received.Load(), counting.sent.Load(), err)
 
-			return res, nil
-		})
+		return err
 	})
 }
+
+// countingHandlerConn counts the messages of a stream.
+type countingHandlerConn struct {
+	connect.StreamingHandlerConn
+
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+func (c *countingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+
+	c.received.Add(1)
+	return nil
+}
+
+func (c *countingHandlerConn) Send(msg any) error {
+	if err := c.StreamingHandlerConn.Send(msg); err != nil {
+		return err
+	}
+
+	c.sent.Add(1)
+	return nil
+}
+
+// loggingClientConn counts the messages of a stream and logs it when the
+// response is closed, which is the last thing a client does with a stream.
+type loggingClientConn struct {
+	connect.StreamingClientConn
+
+	ctx      context.Context
+	start    time.Time
+	received atomic.Int64
+	sent     atomic.Int64
+
+	// err is the first error other than io.EOF, which just ends the stream.
+	err atomic.Pointer[error]
+}
+
+func (c *loggingClientConn) Receive(msg any) error {
+	if err := c.StreamingClientConn.Receive(msg); err != nil {
+		c.setErr(err)
+		return err
+	}
+
+	c.received.Add(1)
+	return nil
+}
+
+func (c *loggingClientConn) Send(msg any) error {
+	if err := c.StreamingClientConn.Send(msg); err != nil {
+		c.setErr(err)
+		return err
+	}
+
+	c.sent.Add(1)
+	return nil
+}
+
+func (c *loggingClientConn) CloseResponse() error {
+	closeErr := c.StreamingClientConn.CloseResponse()
+
+	var err error
+	if p := c.err.Load(); p != nil {
+		err = *p
+	}
+
+	logStream(c.ctx, c.Spec(), c.start, c.received.Load(), c.sent.Load(), err)
+
+	return closeErr
+}
+
+func (c *loggingClientConn) setErr(err error) {
+	if !errors.Is(err, io.EOF) {
+		c.err.CompareAndSwap(nil, &err)
+	}
+}
+
+func logStream(ctx context.Context, spec connect.Spec, start time.Time, received, sent int64, err error) {
+	fields := []any{
+		"procedure", spec.Procedure,
+		"took", time.Since(start),
+		"received", received,
+		"sent", sent,
+	}
+
+	if err != nil {
+		fields = append(fields, errorFields(err)...)
+
+		slog.ErrorCtx(ctx, "stream_error", fields...)
+		return
+	}
+
+	slog.InfoCtx(ctx, "stream_complete", fields...)
+}
+
+func errorFields(err error) []any {
+	fields := []any{"error", err.Error()}
+
+	if e, ok := err.(*server.ServerError); ok && e.Internal != nil {
+		fields = append(fields, "internal_error", e.Internal.Error())
+	}
+
+	return fields
+}
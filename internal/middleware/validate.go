@@ -10,16 +10,66 @@ type validator interface {
 	Validate() error
 }
 
-func NewValidatorInterceptor() connect.UnaryInterceptorFunc {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			if r, ok := req.Any().(validator); ok {
-				if err := r.Validate(); err != nil {
-					return nil, connect.NewError(connect.CodeInvalidArgument, err)
-				}
-			}
-
-			return next(ctx, req)
-		})
+type validatorInterceptor struct{}
+
+// NewValidatorInterceptor validates the messages that clients send and that
+// handlers receive, for unary and streaming RPCs alike.
+func NewValidatorInterceptor() connect.Interceptor {
+	return &validatorInterceptor{}
+}
+
+func (i *validatorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := validate(req.Any()); err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	})
+}
+
+func (i *validatorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return &validatingClientConn{StreamingClientConn: next(ctx, spec)}
+	})
+}
+
+func (i *validatorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingHandlerConn{StreamingHandlerConn: conn})
 	})
 }
+
+type validatingClientConn struct {
+	connect.StreamingClientConn
+}
+
+func (c *validatingClientConn) Send(msg any) error {
+	if err := validate(msg); err != nil {
+		return err
+	}
+
+	return c.StreamingClientConn.Send(msg)
+}
+
+type validatingHandlerConn struct {
+	connect.StreamingHandlerConn
+}
+
+func (c *validatingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+
+	return validate(msg)
+}
+
+func validate(msg any) error {
+	if v, ok := msg.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	return nil
+}
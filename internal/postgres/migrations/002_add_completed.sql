@@ -0,0 +1,10 @@
+-- +goose Up
+alter table todoapp.todo
+    add column completed boolean default false not null,
+    add column completed_at timestamptz;
+
+
+-- +goose Down
+alter table todoapp.todo
+    drop column completed_at,
+    drop column completed;
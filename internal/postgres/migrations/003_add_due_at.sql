@@ -0,0 +1,12 @@
+-- +goose Up
+alter table todoapp.todo
+    add column due_at timestamptz;
+
+create index todo_user_id_due_at_idx on todoapp.todo (user_id, due_at);
+
+
+-- +goose Down
+drop index todoapp.todo_user_id_due_at_idx;
+
+alter table todoapp.todo
+    drop column due_at;
@@ -0,0 +1,27 @@
+-- +goose Up
+create table todoapp.tag (
+    user_id text not null,
+    name text not null,
+    created_at timestamptz default now() not null,
+    primary key (user_id, name)
+);
+
+create table todoapp.todo_tag (
+    user_id text not null,
+    todo_id text not null,
+    name text not null,
+    created_at timestamptz default now() not null,
+    primary key (user_id, todo_id, name),
+    foreign key (user_id, todo_id) references todoapp.todo (user_id, todo_id) on delete cascade,
+    foreign key (user_id, name) references todoapp.tag (user_id, name) on delete cascade
+);
+
+create index todo_tag_user_id_name_idx on todoapp.todo_tag (user_id, name);
+
+grant all on todoapp.tag to todoapp_user;
+grant all on todoapp.todo_tag to todoapp_user;
+
+
+-- +goose Down
+drop table todoapp.todo_tag;
+drop table todoapp.tag;
@@ -0,0 +1,27 @@
+-- +goose Up
+create table todoapp.list (
+    id bigint generated always as identity not null,
+    user_id text not null,
+    list_id text default gen_random_uuid() not null,
+    name text not null,
+    created_at timestamptz default now() not null,
+    updated_at timestamptz default now() not null,
+    primary key (user_id, list_id)
+);
+
+grant all on todoapp.list to todoapp_user;
+
+alter table todoapp.todo
+    add column list_id text,
+    add constraint todo_list_fkey foreign key (user_id, list_id) references todoapp.list (user_id, list_id) on delete cascade;
+
+create index todo_user_id_list_id_idx on todoapp.todo (user_id, list_id);
+
+
+-- +goose Down
+drop index todoapp.todo_user_id_list_id_idx;
+
+alter table todoapp.todo
+    drop column list_id;
+
+drop table todoapp.list;
@@ -0,0 +1,12 @@
+-- +goose Up
+alter table todoapp.todo
+    add column search_vector tsvector generated always as (to_tsvector('english', todo)) stored not null;
+
+create index todo_search_vector_idx on todoapp.todo using gin (search_vector);
+
+
+-- +goose Down
+drop index todoapp.todo_search_vector_idx;
+
+alter table todoapp.todo
+    drop column search_vector;
@@ -0,0 +1,25 @@
+-- +goose Up
+alter table todoapp.todo
+    add column version bigint default 1 not null;
+
+-- Every update bumps the version, so clients can detect concurrent changes.
+-- +goose StatementBegin
+create function todoapp.bump_version() returns trigger as $$
+begin
+    new.version := old.version + 1;
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_bump_version
+    before update on todoapp.todo
+    for each row execute function todoapp.bump_version();
+
+
+-- +goose Down
+drop trigger todo_bump_version on todoapp.todo;
+drop function todoapp.bump_version;
+
+alter table todoapp.todo
+    drop column version;
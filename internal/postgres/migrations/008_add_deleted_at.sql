@@ -0,0 +1,12 @@
+-- +goose Up
+alter table todoapp.todo
+    add column deleted_at timestamptz;
+
+create index todo_deleted_at_idx on todoapp.todo (deleted_at) where deleted_at is not null;
+
+
+-- +goose Down
+drop index todoapp.todo_deleted_at_idx;
+
+alter table todoapp.todo
+    drop column deleted_at;
@@ -0,0 +1,17 @@
+-- +goose Up
+create table todoapp.idempotency_key (
+    user_id text not null,
+    key text not null,
+    response bytea not null,
+    created_at timestamptz default now() not null,
+    expires_at timestamptz not null,
+    primary key (user_id, key)
+);
+
+create index idempotency_key_expires_at_idx on todoapp.idempotency_key (expires_at);
+
+grant all on todoapp.idempotency_key to todoapp_user;
+
+
+-- +goose Down
+drop table todoapp.idempotency_key;
@@ -0,0 +1,66 @@
+-- +goose Up
+create table todoapp.todo_event (
+    id bigint generated always as identity not null,
+    user_id text not null,
+    todo_id text not null,
+    kind text not null,
+    created_at timestamptz default now() not null,
+    primary key (id)
+);
+
+create index todo_event_user_id_id_idx on todoapp.todo_event (user_id, id);
+create index todo_event_created_at_idx on todoapp.todo_event (created_at);
+
+grant all on todoapp.todo_event to todoapp_user;
+
+-- Records a created, updated or deleted event for every change to a todo, and
+-- notifies listeners on the todo_event channel with the user id. Moving a todo
+-- to the trash is a delete and restoring it is a create.
+-- +goose StatementBegin
+create function todoapp.record_todo_event() returns trigger as $$
+declare
+    r record;
+    event_kind text;
+begin
+    if tg_op = 'INSERT' then
+        r := new;
+        event_kind := 'created';
+    elsif tg_op = 'DELETE' then
+        if old.deleted_at is not null then
+            -- Purged from the trash; the delete was already recorded.
+            return null;
+        end if;
+        r := old;
+        event_kind := 'deleted';
+    elsif old.deleted_at is null and new.deleted_at is not null then
+        r := new;
+        event_kind := 'deleted';
+    elsif old.deleted_at is not null and new.deleted_at is null then
+        r := new;
+        event_kind := 'created';
+    elsif new.deleted_at is not null then
+        return null;
+    else
+        r := new;
+        event_kind := 'updated';
+    end if;
+
+    insert into todoapp.todo_event (user_id, todo_id, kind)
+    values (r.user_id, r.todo_id, event_kind);
+
+    perform pg_notify('todo_event', r.user_id);
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_record_event
+    after insert or update or delete on todoapp.todo
+    for each row execute function todoapp.record_todo_event();
+
+
+-- +goose Down
+drop trigger todo_record_event on todoapp.todo;
+drop function todoapp.record_todo_event;
+drop table todoapp.todo_event;
@@ -0,0 +1,103 @@
+-- +goose Up
+-- The latest change sequence number of each user. Every change to a user's
+-- todos takes the next number while holding a lock on the user's row, so
+-- changes commit in sequence order and Sync never skips one.
+create table todoapp.change_seq (
+    user_id text not null,
+    seq bigint not null,
+    primary key (user_id)
+);
+
+grant all on todoapp.change_seq to todoapp_user;
+
+-- Deleted todos are remembered so that Sync can report them.
+create table todoapp.todo_tombstone (
+    user_id text not null,
+    todo_id text not null,
+    change_seq bigint not null,
+    deleted_at timestamptz default now() not null,
+    primary key (user_id, todo_id)
+);
+
+create index todo_tombstone_user_id_change_seq_idx on todoapp.todo_tombstone (user_id, change_seq);
+
+grant all on todoapp.todo_tombstone to todoapp_user;
+
+alter table todoapp.todo
+    add column change_seq bigint;
+
+-- Number the existing todos without bumping their versions or recording
+-- events.
+alter table todoapp.todo disable trigger todo_bump_version;
+alter table todoapp.todo disable trigger todo_record_event;
+
+update todoapp.todo t
+set change_seq = n.seq
+from (
+    select id, row_number() over (partition by user_id order by id) as seq
+    from todoapp.todo
+) n
+where t.id = n.id;
+
+alter table todoapp.todo enable trigger todo_bump_version;
+alter table todoapp.todo enable trigger todo_record_event;
+
+insert into todoapp.change_seq (user_id, seq)
+select user_id, max(change_seq)
+from todoapp.todo
+group by user_id;
+
+alter table todoapp.todo
+    alter column change_seq set not null;
+
+create index todo_user_id_change_seq_idx on todoapp.todo (user_id, change_seq);
+
+-- +goose StatementBegin
+create function todoapp.next_change_seq(uid text) returns bigint as $$
+    insert into todoapp.change_seq as c (user_id, seq)
+    values (uid, 1)
+    on conflict (user_id) do update set seq = c.seq + 1
+    returning seq;
+$$ language sql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create function todoapp.set_change_seq() returns trigger as $$
+begin
+    new.change_seq := todoapp.next_change_seq(new.user_id);
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_set_change_seq
+    before insert or update on todoapp.todo
+    for each row execute function todoapp.set_change_seq();
+
+-- +goose StatementBegin
+create function todoapp.record_todo_tombstone() returns trigger as $$
+begin
+    insert into todoapp.todo_tombstone (user_id, todo_id, change_seq)
+    values (old.user_id, old.todo_id, todoapp.next_change_seq(old.user_id));
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_record_tombstone
+    after delete on todoapp.todo
+    for each row execute function todoapp.record_todo_tombstone();
+
+
+-- +goose Down
+drop trigger todo_record_tombstone on todoapp.todo;
+drop function todoapp.record_todo_tombstone;
+drop trigger todo_set_change_seq on todoapp.todo;
+drop function todoapp.set_change_seq;
+drop function todoapp.next_change_seq;
+
+alter table todoapp.todo
+    drop column change_seq;
+
+drop table todoapp.todo_tombstone;
+drop table todoapp.change_seq;
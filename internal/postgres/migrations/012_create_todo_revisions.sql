@@ -0,0 +1,46 @@
+-- +goose Up
+create table todoapp.todo_revision (
+    id bigint generated always as identity not null,
+    user_id text not null,
+    todo_id text not null,
+    version bigint not null,
+    todo text not null,
+    due_at timestamptz,
+    created_at timestamptz default now() not null,
+    primary key (id),
+    unique (user_id, todo_id, version),
+    foreign key (user_id, todo_id) references todoapp.todo (user_id, todo_id) on delete cascade
+);
+
+grant all on todoapp.todo_revision to todoapp_user;
+
+insert into todoapp.todo_revision (user_id, todo_id, version, todo, due_at, created_at)
+select user_id, todo_id, version, todo, due_at, updated_at
+from todoapp.todo;
+
+-- Records the todo's text and due date whenever either changes. Other
+-- changes, such as completing the todo, are not revisions.
+-- +goose StatementBegin
+create function todoapp.record_todo_revision() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and new.todo is not distinct from old.todo and new.due_at is not distinct from old.due_at then
+        return null;
+    end if;
+
+    insert into todoapp.todo_revision (user_id, todo_id, version, todo, due_at)
+    values (new.user_id, new.todo_id, new.version, new.todo, new.due_at);
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_record_revision
+    after insert or update on todoapp.todo
+    for each row execute function todoapp.record_todo_revision();
+
+
+-- +goose Down
+drop trigger todo_record_revision on todoapp.todo;
+drop function todoapp.record_todo_revision;
+drop table todoapp.todo_revision;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- The most recent creates, updates and deletes of each user, newest last, so
+-- that they can be undone.
+create table todoapp.operation (
+    id bigint generated always as identity not null,
+    user_id text not null,
+    todo_id text not null,
+    kind text not null,
+    -- The todo's version right after the operation. Undo is refused unless
+    -- the todo is still at this version.
+    version bigint not null,
+    -- For updates, the text and due date to go back to.
+    todo text,
+    due_at timestamptz,
+    created_at timestamptz default now() not null,
+    primary key (id)
+);
+
+create index operation_user_id_id_idx on todoapp.operation (user_id, id);
+
+grant all on todoapp.operation to todoapp_user;
+
+
+-- +goose Down
+drop table todoapp.operation;
@@ -0,0 +1,90 @@
+-- +goose Up
+alter table todoapp.todo
+    add column parent_todo_id text,
+    add column child_count integer default 0 not null,
+    add column completed_child_count integer default 0 not null,
+    add constraint todo_parent_fkey foreign key (user_id, parent_todo_id) references todoapp.todo (user_id, todo_id) on delete cascade;
+
+create index todo_user_id_parent_todo_id_idx on todoapp.todo (user_id, parent_todo_id);
+
+-- Refuses to make a todo its own ancestor. The trigger is named so that it
+-- runs after todo_set_change_seq, which locks the user's todos against
+-- concurrent reparenting.
+-- +goose StatementBegin
+create function todoapp.validate_parent_todo() returns trigger as $$
+begin
+    if exists (
+        with recursive ancestor (todo_id, parent_todo_id) as (
+            select todo_id, parent_todo_id
+            from todoapp.todo
+            where user_id = new.user_id and todo_id = new.parent_todo_id
+            union
+            select t.todo_id, t.parent_todo_id
+            from todoapp.todo t
+            join ancestor a on t.todo_id = a.parent_todo_id
+            where t.user_id = new.user_id
+        )
+        select 1 from ancestor where todo_id = new.todo_id
+    ) then
+        raise exception 'todo % cannot be its own ancestor', new.todo_id
+            using errcode = 'check_violation', constraint = 'todo_parent_cycle';
+    end if;
+
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_validate_parent
+    before update of parent_todo_id on todoapp.todo
+    for each row
+    when (new.parent_todo_id is not null and new.parent_todo_id is distinct from old.parent_todo_id)
+    execute function todoapp.validate_parent_todo();
+
+-- Keeps the child counts of parents up to date. Children in the trash are not
+-- counted.
+-- +goose StatementBegin
+create function todoapp.count_child_todos() returns trigger as $$
+begin
+    if tg_op = 'UPDATE'
+        and new.parent_todo_id is not distinct from old.parent_todo_id
+        and new.completed = old.completed
+        and (new.deleted_at is null) = (old.deleted_at is null) then
+        return null;
+    end if;
+
+    if tg_op <> 'INSERT' and old.parent_todo_id is not null and old.deleted_at is null then
+        update todoapp.todo
+        set child_count = child_count - 1,
+            completed_child_count = completed_child_count - old.completed::integer
+        where user_id = old.user_id and todo_id = old.parent_todo_id;
+    end if;
+
+    if tg_op <> 'DELETE' and new.parent_todo_id is not null and new.deleted_at is null then
+        update todoapp.todo
+        set child_count = child_count + 1,
+            completed_child_count = completed_child_count + new.completed::integer
+        where user_id = new.user_id and todo_id = new.parent_todo_id;
+    end if;
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_count_children
+    after insert or update or delete on todoapp.todo
+    for each row execute function todoapp.count_child_todos();
+
+
+-- +goose Down
+drop trigger todo_count_children on todoapp.todo;
+drop function todoapp.count_child_todos;
+drop trigger todo_validate_parent on todoapp.todo;
+drop function todoapp.validate_parent_todo;
+drop index todoapp.todo_user_id_parent_todo_id_idx;
+
+alter table todoapp.todo
+    drop column completed_child_count,
+    drop column child_count,
+    drop column parent_todo_id;
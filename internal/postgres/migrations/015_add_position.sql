@@ -0,0 +1,85 @@
+-- +goose Up
+-- The manual order of a todo within its list. Positions are strings of base 62
+-- digits, compared bytewise, so there is always room to move a todo between
+-- two others without renumbering.
+alter table todoapp.todo
+    add column position text collate "C";
+
+-- Number the existing todos of each list in creation order, without bumping
+-- their versions or recording events.
+alter table todoapp.todo disable trigger todo_bump_version;
+alter table todoapp.todo disable trigger todo_record_event;
+alter table todoapp.todo disable trigger todo_set_change_seq;
+
+update todoapp.todo t
+set position = lpad(n.seq::text, 10, '0') || '1'
+from (
+    select id, row_number() over (partition by user_id, list_id order by id) as seq
+    from todoapp.todo
+) n
+where t.id = n.id;
+
+alter table todoapp.todo enable trigger todo_bump_version;
+alter table todoapp.todo enable trigger todo_record_event;
+alter table todoapp.todo enable trigger todo_set_change_seq;
+
+alter table todoapp.todo
+    alter column position set not null;
+
+create index todo_user_id_list_id_position_idx on todoapp.todo (user_id, list_id, position, id);
+
+-- Returns a position after p, or the first position if p is null.
+-- +goose StatementBegin
+create function todoapp.position_after(p text) returns text as $$
+declare
+    digits constant text := '0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz';
+    d integer;
+begin
+    if p is null or p = '' then
+        return '1';
+    end if;
+
+    d := strpos(digits, left(p, 1));
+    if d < length(digits) then
+        return substr(digits, d + 1, 1);
+    end if;
+
+    return left(p, 1) || todoapp.position_after(substr(p, 2));
+end;
+$$ language plpgsql immutable;
+-- +goose StatementEnd
+
+-- Puts new todos, and todos moved to another list, at the end of their list.
+-- The trigger is named so that it runs after todo_set_change_seq, which locks
+-- the user's todos, so concurrent creates do not get the same position.
+-- +goose StatementBegin
+create function todoapp.set_position() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and new.list_id is not distinct from old.list_id then
+        return new;
+    end if;
+
+    new.position := todoapp.position_after((
+        select max(position)
+        from todoapp.todo
+        where user_id = new.user_id and list_id is not distinct from new.list_id
+    ));
+
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_set_position
+    before insert or update of list_id on todoapp.todo
+    for each row execute function todoapp.set_position();
+
+
+-- +goose Down
+drop trigger todo_set_position on todoapp.todo;
+drop function todoapp.set_position;
+drop function todoapp.position_after;
+drop index todoapp.todo_user_id_list_id_position_idx;
+
+alter table todoapp.todo
+    drop column position;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- The iCalendar RRULE that a repeating todo repeats by. Completing the todo
+-- creates its next occurrence, which the rule moves to.
+alter table todoapp.todo
+    add column rrule text;
+
+
+-- +goose Down
+alter table todoapp.todo
+    drop column rrule;
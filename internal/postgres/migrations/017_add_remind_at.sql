@@ -0,0 +1,56 @@
+-- +goose Up
+alter table todoapp.todo
+    add column remind_at timestamptz;
+
+-- A todo's reminder and whether it has been sent. The scheduler locks these
+-- rather than the todos, so sending a reminder does not change the todo or
+-- hold up updates to it.
+create table todoapp.reminder (
+    user_id text not null,
+    todo_id text not null,
+    remind_at timestamptz not null,
+    sent_at timestamptz,
+    primary key (user_id, todo_id),
+    foreign key (user_id, todo_id) references todoapp.todo (user_id, todo_id) on delete cascade
+);
+
+create index reminder_remind_at_idx on todoapp.reminder (remind_at) where sent_at is null;
+
+grant all on todoapp.reminder to todoapp_user;
+
+-- Keeps the reminder in step with the todo's remind_at. Changing remind_at
+-- schedules a new reminder, even if the old one was sent.
+-- +goose StatementBegin
+create function todoapp.sync_reminder() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and new.remind_at is not distinct from old.remind_at then
+        return null;
+    end if;
+
+    if new.remind_at is null then
+        delete from todoapp.reminder
+        where user_id = new.user_id and todo_id = new.todo_id;
+    else
+        insert into todoapp.reminder (user_id, todo_id, remind_at)
+        values (new.user_id, new.todo_id, new.remind_at)
+        on conflict (user_id, todo_id) do update
+        set remind_at = excluded.remind_at, sent_at = null;
+    end if;
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger todo_sync_reminder
+    after insert or update of remind_at on todoapp.todo
+    for each row execute function todoapp.sync_reminder();
+
+
+-- +goose Down
+drop trigger todo_sync_reminder on todoapp.todo;
+drop function todoapp.sync_reminder;
+drop table todoapp.reminder;
+
+alter table todoapp.todo
+    drop column remind_at;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- 0 is no priority, then low, medium, high and urgent, as in the Priority
+-- enum.
+alter table todoapp.todo
+    add column priority smallint default 0 not null,
+    add constraint todo_priority check (priority between 0 and 4);
+
+
+-- +goose Down
+alter table todoapp.todo
+    drop column priority;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Grants user_id access to owner_id's list, and so to the todos in it.
+-- Viewers may read the todos and editors may also change them.
+create table todoapp.list_share (
+    owner_id text not null,
+    list_id text not null,
+    user_id text not null,
+    role text not null,
+    created_at timestamptz default now() not null,
+    primary key (owner_id, list_id, user_id),
+    constraint list_share_list_fkey foreign key (owner_id, list_id) references todoapp.list (user_id, list_id) on delete cascade,
+    constraint list_share_role check (role in ('viewer', 'editor')),
+    constraint list_share_not_owner check (user_id <> owner_id)
+);
+
+create index list_share_user_id_list_id_idx on todoapp.list_share (user_id, list_id);
+
+grant all on todoapp.list_share to todoapp_user;
+
+
+-- +goose Down
+drop table todoapp.list_share;
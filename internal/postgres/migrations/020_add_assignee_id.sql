@@ -0,0 +1,30 @@
+-- +goose Up
+alter table todoapp.todo
+    add column assignee_id text;
+
+create index todo_assignee_id_idx on todoapp.todo (assignee_id) where assignee_id is not null;
+
+-- Every change of a todo's assignee, and who made it. assignee_id is null
+-- when the todo was unassigned. user_id is the todo's owner.
+create table todoapp.assignment (
+    id bigint generated always as identity not null,
+    user_id text not null,
+    todo_id text not null,
+    assignee_id text,
+    assigned_by text not null,
+    created_at timestamptz default now() not null,
+    primary key (id),
+    foreign key (user_id, todo_id) references todoapp.todo (user_id, todo_id) on delete cascade
+);
+
+create index assignment_user_id_todo_id_idx on todoapp.assignment (user_id, todo_id, id);
+
+grant all on todoapp.assignment to todoapp_user;
+
+
+-- +goose Down
+drop table todoapp.assignment;
+drop index todoapp.todo_assignee_id_idx;
+
+alter table todoapp.todo
+    drop column assignee_id;
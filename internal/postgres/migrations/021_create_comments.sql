@@ -0,0 +1,23 @@
+-- +goose Up
+-- Comments on owner_id's todo. user_id is the comment's author, who may be
+-- any user the todo is shared with.
+create table todoapp.comment (
+    id bigint generated always as identity not null,
+    comment_id text default gen_random_uuid() not null,
+    owner_id text not null,
+    todo_id text not null,
+    user_id text not null,
+    body text not null,
+    created_at timestamptz default now() not null,
+    updated_at timestamptz default now() not null,
+    primary key (comment_id),
+    constraint comment_todo_fkey foreign key (owner_id, todo_id) references todoapp.todo (user_id, todo_id) on delete cascade
+);
+
+create index comment_owner_id_todo_id_idx on todoapp.comment (owner_id, todo_id, id);
+
+grant all on todoapp.comment to todoapp_user;
+
+
+-- +goose Down
+drop table todoapp.comment;
@@ -0,0 +1,124 @@
+-- +goose Up
+-- Events are numbered per user from the user's change sequence, which is
+-- taken under a lock on the user's change_seq row, so a user's events commit
+-- in seq order. Watch relies on this to never skip an event. Identity ids do
+-- not work, because concurrent transactions can commit out of id order.
+alter table todoapp.todo_event
+    add column seq bigint;
+
+update todoapp.todo_event e
+set seq = n.seq
+from (
+    select id, row_number() over (partition by user_id order by id) as seq
+    from todoapp.todo_event
+) n
+where e.id = n.id;
+
+-- Existing events take the first numbers, so the user's later changes must
+-- come after them.
+insert into todoapp.change_seq as c (user_id, seq)
+select user_id, max(seq)
+from todoapp.todo_event
+group by user_id
+on conflict (user_id) do update set seq = greatest(c.seq, excluded.seq);
+
+alter table todoapp.todo_event
+    alter column seq set not null;
+
+drop index todoapp.todo_event_user_id_id_idx;
+create unique index todo_event_user_id_seq_idx on todoapp.todo_event (user_id, seq);
+
+-- A change to a todo already took the next number in todo_set_change_seq. A
+-- delete does not, so takes one here.
+-- +goose StatementBegin
+create or replace function todoapp.record_todo_event() returns trigger as $$
+declare
+    r record;
+    event_kind text;
+    event_seq bigint;
+begin
+    if tg_op = 'INSERT' then
+        r := new;
+        event_kind := 'created';
+    elsif tg_op = 'DELETE' then
+        if old.deleted_at is not null then
+            -- Purged from the trash; the delete was already recorded.
+            return null;
+        end if;
+        r := old;
+        event_kind := 'deleted';
+    elsif old.deleted_at is null and new.deleted_at is not null then
+        r := new;
+        event_kind := 'deleted';
+    elsif old.deleted_at is not null and new.deleted_at is null then
+        r := new;
+        event_kind := 'created';
+    elsif new.deleted_at is not null then
+        return null;
+    else
+        r := new;
+        event_kind := 'updated';
+    end if;
+
+    if tg_op = 'DELETE' then
+        event_seq := todoapp.next_change_seq(r.user_id);
+    else
+        event_seq := r.change_seq;
+    end if;
+
+    insert into todoapp.todo_event (user_id, todo_id, kind, seq)
+    values (r.user_id, r.todo_id, event_kind, event_seq);
+
+    perform pg_notify('todo_event', r.user_id);
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+create or replace function todoapp.record_todo_event() returns trigger as $$
+declare
+    r record;
+    event_kind text;
+begin
+    if tg_op = 'INSERT' then
+        r := new;
+        event_kind := 'created';
+    elsif tg_op = 'DELETE' then
+        if old.deleted_at is not null then
+            -- Purged from the trash; the delete was already recorded.
+            return null;
+        end if;
+        r := old;
+        event_kind := 'deleted';
+    elsif old.deleted_at is null and new.deleted_at is not null then
+        r := new;
+        event_kind := 'deleted';
+    elsif old.deleted_at is not null and new.deleted_at is null then
+        r := new;
+        event_kind := 'created';
+    elsif new.deleted_at is not null then
+        return null;
+    else
+        r := new;
+        event_kind := 'updated';
+    end if;
+
+    insert into todoapp.todo_event (user_id, todo_id, kind)
+    values (r.user_id, r.todo_id, event_kind);
+
+    perform pg_notify('todo_event', r.user_id);
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+drop index todoapp.todo_event_user_id_seq_idx;
+create index todo_event_user_id_id_idx on todoapp.todo_event (user_id, id);
+
+alter table todoapp.todo_event
+    drop column seq;
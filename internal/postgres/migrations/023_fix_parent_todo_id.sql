@@ -0,0 +1,40 @@
+-- +goose Up
+-- Purging a parent from the trash makes its subtasks top-level todos rather
+-- than deleting them along with it.
+alter table todoapp.todo
+    drop constraint todo_parent_fkey,
+    add constraint todo_parent_fkey foreign key (user_id, parent_todo_id) references todoapp.todo (user_id, todo_id) on delete set null (parent_todo_id);
+
+-- Every update bumps the version, except for count_child_todos updating the
+-- child counts, so that changes to subtasks do not make expected_version
+-- checks and Undo on the parent fail. Generated columns such as
+-- search_vector are null in new until after this trigger, so they are left out
+-- of the comparison.
+-- +goose StatementBegin
+create or replace function todoapp.bump_version() returns trigger as $$
+begin
+    if (new.child_count, new.completed_child_count) is distinct from (old.child_count, old.completed_child_count)
+        and to_jsonb(new) - array['child_count', 'completed_child_count', 'search_vector'] = to_jsonb(old) - array['child_count', 'completed_child_count', 'search_vector'] then
+        return new;
+    end if;
+
+    new.version := old.version + 1;
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+create or replace function todoapp.bump_version() returns trigger as $$
+begin
+    new.version := old.version + 1;
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+alter table todoapp.todo
+    drop constraint todo_parent_fkey,
+    add constraint todo_parent_fkey foreign key (user_id, parent_todo_id) references todoapp.todo (user_id, todo_id) on delete cascade;
@@ -0,0 +1,57 @@
+-- +goose Up
+-- When a scheduler claimed the reminder to send it. Reminders are claimed in a
+-- short transaction and sent outside of it, so a claim that is never marked as
+-- sent or released, because its scheduler died, expires instead.
+alter table todoapp.reminder
+    add column claimed_at timestamptz;
+
+-- Changing remind_at schedules a new reminder, even if the old one was sent or
+-- is being sent.
+-- +goose StatementBegin
+create or replace function todoapp.sync_reminder() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and new.remind_at is not distinct from old.remind_at then
+        return null;
+    end if;
+
+    if new.remind_at is null then
+        delete from todoapp.reminder
+        where user_id = new.user_id and todo_id = new.todo_id;
+    else
+        insert into todoapp.reminder (user_id, todo_id, remind_at)
+        values (new.user_id, new.todo_id, new.remind_at)
+        on conflict (user_id, todo_id) do update
+        set remind_at = excluded.remind_at, sent_at = null, claimed_at = null;
+    end if;
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+create or replace function todoapp.sync_reminder() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and new.remind_at is not distinct from old.remind_at then
+        return null;
+    end if;
+
+    if new.remind_at is null then
+        delete from todoapp.reminder
+        where user_id = new.user_id and todo_id = new.todo_id;
+    else
+        insert into todoapp.reminder (user_id, todo_id, remind_at)
+        values (new.user_id, new.todo_id, new.remind_at)
+        on conflict (user_id, todo_id) do update
+        set remind_at = excluded.remind_at, sent_at = null;
+    end if;
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+alter table todoapp.reminder
+    drop column claimed_at;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- For updates, the other fields that Update can change, so that Undo can put
+-- them back too.
+alter table todoapp.operation
+    add column parent_todo_id text,
+    add column rrule text,
+    add column remind_at timestamptz,
+    add column priority smallint;
+
+-- Undoing an update logged before these were stored leaves them as they are.
+update todoapp.operation o
+set parent_todo_id = t.parent_todo_id,
+    rrule = t.rrule,
+    remind_at = t.remind_at,
+    priority = t.priority
+from todoapp.todo t
+where o.kind = 'update' and t.user_id = o.user_id and t.todo_id = o.todo_id;
+
+
+-- +goose Down
+alter table todoapp.operation
+    drop column priority,
+    drop column remind_at,
+    drop column rrule,
+    drop column parent_todo_id;
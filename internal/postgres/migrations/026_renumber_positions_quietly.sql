@@ -0,0 +1,230 @@
+-- +goose Up
+-- Whether the update changed some of cols and nothing else. Generated columns
+-- such as search_vector are null in new in before triggers, so they are left
+-- out of the comparison.
+-- +goose StatementBegin
+create function todoapp.changed_only(o todoapp.todo, n todoapp.todo, cols text[]) returns boolean as $$
+    select to_jsonb(n) - cols - 'search_vector' = to_jsonb(o) - cols - 'search_vector'
+        and to_jsonb(n) - 'search_vector' <> to_jsonb(o) - 'search_vector';
+$$ language sql immutable;
+-- +goose StatementEnd
+
+-- Numbers the todos of a list in their current order, as 015_add_position
+-- did. Only positions change, so no versions are bumped and no events are
+-- recorded. The caller must hold the lock on the user's change_seq row.
+-- +goose StatementBegin
+create function todoapp.renumber_positions(uid text, lid text) returns void as $$
+    update todoapp.todo t
+    set position = lpad(n.seq::text, 10, '0') || '1'
+    from (
+        select id, row_number() over (order by position, id) as seq
+        from todoapp.todo
+        where user_id = uid and list_id is not distinct from lid
+    ) n
+    where t.id = n.id;
+$$ language sql;
+-- +goose StatementEnd
+
+-- Every update bumps the version, except for count_child_todos updating the
+-- child counts and renumber_positions updating positions, so that neither
+-- makes expected_version checks and Undo fail.
+-- +goose StatementBegin
+create or replace function todoapp.bump_version() returns trigger as $$
+begin
+    if todoapp.changed_only(old, new, array['child_count', 'completed_child_count', 'position']) then
+        return new;
+    end if;
+
+    new.version := old.version + 1;
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+-- Renumbering positions is not a change that Sync reports.
+-- +goose StatementBegin
+create or replace function todoapp.set_change_seq() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and todoapp.changed_only(old, new, array['position']) then
+        return new;
+    end if;
+
+    new.change_seq := todoapp.next_change_seq(new.user_id);
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+-- Nor one that Watch reports.
+-- +goose StatementBegin
+create or replace function todoapp.record_todo_event() returns trigger as $$
+declare
+    r record;
+    event_kind text;
+    event_seq bigint;
+begin
+    if tg_op = 'INSERT' then
+        r := new;
+        event_kind := 'created';
+    elsif tg_op = 'DELETE' then
+        if old.deleted_at is not null then
+            -- Purged from the trash; the delete was already recorded.
+            return null;
+        end if;
+        r := old;
+        event_kind := 'deleted';
+    elsif old.deleted_at is null and new.deleted_at is not null then
+        r := new;
+        event_kind := 'deleted';
+    elsif old.deleted_at is not null and new.deleted_at is null then
+        r := new;
+        event_kind := 'created';
+    elsif new.deleted_at is not null or todoapp.changed_only(old, new, array['position']) then
+        return null;
+    else
+        r := new;
+        event_kind := 'updated';
+    end if;
+
+    if tg_op = 'DELETE' then
+        event_seq := todoapp.next_change_seq(r.user_id);
+    else
+        event_seq := r.change_seq;
+    end if;
+
+    insert into todoapp.todo_event (user_id, todo_id, kind, seq)
+    values (r.user_id, r.todo_id, event_kind, event_seq);
+
+    perform pg_notify('todo_event', r.user_id);
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+-- Appending makes positions a digit longer every 61 todos, so the list is
+-- renumbered once the last position gets long. The limit matches
+-- maxPositionLen in the server.
+-- +goose StatementBegin
+create or replace function todoapp.set_position() returns trigger as $$
+declare
+    last_position text;
+begin
+    if tg_op = 'UPDATE' and new.list_id is not distinct from old.list_id then
+        return new;
+    end if;
+
+    last_position := (
+        select max(position)
+        from todoapp.todo
+        where user_id = new.user_id and list_id is not distinct from new.list_id
+    );
+
+    if length(last_position) > 50 then
+        perform todoapp.renumber_positions(new.user_id, new.list_id);
+
+        last_position := (
+            select max(position)
+            from todoapp.todo
+            where user_id = new.user_id and list_id is not distinct from new.list_id
+        );
+    end if;
+
+    new.position := todoapp.position_after(last_position);
+
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+create or replace function todoapp.set_position() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and new.list_id is not distinct from old.list_id then
+        return new;
+    end if;
+
+    new.position := todoapp.position_after((
+        select max(position)
+        from todoapp.todo
+        where user_id = new.user_id and list_id is not distinct from new.list_id
+    ));
+
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create or replace function todoapp.record_todo_event() returns trigger as $$
+declare
+    r record;
+    event_kind text;
+    event_seq bigint;
+begin
+    if tg_op = 'INSERT' then
+        r := new;
+        event_kind := 'created';
+    elsif tg_op = 'DELETE' then
+        if old.deleted_at is not null then
+            -- Purged from the trash; the delete was already recorded.
+            return null;
+        end if;
+        r := old;
+        event_kind := 'deleted';
+    elsif old.deleted_at is null and new.deleted_at is not null then
+        r := new;
+        event_kind := 'deleted';
+    elsif old.deleted_at is not null and new.deleted_at is null then
+        r := new;
+        event_kind := 'created';
+    elsif new.deleted_at is not null then
+        return null;
+    else
+        r := new;
+        event_kind := 'updated';
+    end if;
+
+    if tg_op = 'DELETE' then
+        event_seq := todoapp.next_change_seq(r.user_id);
+    else
+        event_seq := r.change_seq;
+    end if;
+
+    insert into todoapp.todo_event (user_id, todo_id, kind, seq)
+    values (r.user_id, r.todo_id, event_kind, event_seq);
+
+    perform pg_notify('todo_event', r.user_id);
+
+    return null;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create or replace function todoapp.set_change_seq() returns trigger as $$
+begin
+    new.change_seq := todoapp.next_change_seq(new.user_id);
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create or replace function todoapp.bump_version() returns trigger as $$
+begin
+    if (new.child_count, new.completed_child_count) is distinct from (old.child_count, old.completed_child_count)
+        and to_jsonb(new) - array['child_count', 'completed_child_count', 'search_vector'] = to_jsonb(old) - array['child_count', 'completed_child_count', 'search_vector'] then
+        return new;
+    end if;
+
+    new.version := old.version + 1;
+    return new;
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+drop function todoapp.renumber_positions;
+drop function todoapp.changed_only;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- A hash of the request, so that reusing a key for a different request can be
+-- refused rather than replaying the other request's response. Keys recorded
+-- before this have no hash and are not checked.
+alter table todoapp.idempotency_key
+    add column request_hash bytea;
+
+
+-- +goose Down
+alter table todoapp.idempotency_key
+    drop column request_hash;
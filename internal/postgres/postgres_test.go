@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +12,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -18,7 +22,10 @@ import (
 
 const aTodo = "buy veggies"
 
-var q *sqlc.Queries
+var (
+	pool *pgxpool.Pool
+	q    *sqlc.Queries
+)
 
 func TestMain(m *testing.M) {
 	ctx := context.Background()
@@ -48,7 +55,7 @@ func TestMain(m *testing.M) {
 		panic(err)
 	}
 
-	pool := MustNew(&Config{
+	pool = MustNew(&Config{
 		ConnString:        fmt.Sprintf("postgres://authenticator:password@%s:%s/postgres", host, port.Port()),
 		Migrate:           true,
 		MigrateConnString: fmt.Sprintf("postgres://postgres:password@%s:%s/postgres", host, port.Port()),
@@ -114,6 +121,7 @@ func TestReadAll(t *testing.T) {
 
 	posts, err := q.ReadPage(ctx, sqlc.ReadPageParams{
 		UserID: userID,
+		Limit:  100,
 	})
 	require.NoError(t, err)
 
@@ -121,6 +129,169 @@ func TestReadAll(t *testing.T) {
 
 	require.True(t, cmp.Equal(post1, posts[0]))
 	require.True(t, cmp.Equal(post2, posts[1]))
+
+	t.Run("paginates", func(t *testing.T) {
+		page, err := q.ReadPage(ctx, sqlc.ReadPageParams{
+			UserID: userID,
+			ID:     post1.ID,
+			Limit:  1,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, page, 1)
+		require.True(t, cmp.Equal(post2, page[0]))
+	})
+}
+
+func TestReadPageDueFilters(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+	now := time.Now()
+
+	overdue, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+		DueAt:  pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	upcoming, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+		DueAt:  pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	noDueDate, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+
+	todos, err := q.ReadPage(ctx, sqlc.ReadPageParams{
+		UserID:  userID,
+		Overdue: true,
+		Limit:   100,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	require.Equal(t, overdue.TodoID, todos[0].TodoID)
+
+	todos, err = q.ReadPage(ctx, sqlc.ReadPageParams{
+		UserID:    userID,
+		DueBefore: pgtype.Timestamptz{Time: now.Add(2 * time.Hour), Valid: true},
+		DueAfter:  pgtype.Timestamptz{Time: now, Valid: true},
+		Limit:     100,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	require.Equal(t, upcoming.TodoID, todos[0].TodoID)
+
+	todos, err = q.ReadPage(ctx, sqlc.ReadPageParams{
+		UserID:    userID,
+		NoDueDate: true,
+		Limit:     100,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	require.Equal(t, noDueDate.TodoID, todos[0].TodoID)
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	now := time.Now()
+	dueAts := []pgtype.Timestamptz{
+		{Time: now.Add(2 * time.Hour), Valid: true},
+		{},
+		{Time: now.Add(time.Hour), Valid: true},
+	}
+
+	var todos []sqlc.TodoappTodo
+	for i, dueAt := range dueAts {
+		todo, err := q.Create(ctx, sqlc.CreateParams{
+			UserID: userID,
+			Todo:   fmt.Sprintf("Buy Veggies %d", i),
+			DueAt:  dueAt,
+		})
+		require.NoError(t, err)
+		todos = append(todos, todo)
+	}
+
+	t.Run("sorts by due date with no due date last", func(t *testing.T) {
+		rows, err := q.List(ctx, sqlc.ListParams{
+			UserID: userID,
+			SortBy: "due_at",
+			Limit:  100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{todos[2].TodoID, todos[0].TodoID, todos[1].TodoID}, listTodoIDs(rows))
+	})
+
+	t.Run("resumes after the cursor", func(t *testing.T) {
+		rows, err := q.List(ctx, sqlc.ListParams{
+			UserID:     userID,
+			HasCursor:  true,
+			Descending: true,
+			SortBy:     "created_at",
+			CursorKey:  todos[2].CreatedAt,
+			CursorID:   todos[2].ID,
+			Limit:      100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{todos[1].TodoID, todos[0].TodoID}, listTodoIDs(rows))
+	})
+
+	t.Run("filters by text", func(t *testing.T) {
+		rows, err := q.List(ctx, sqlc.ListParams{
+			UserID:       userID,
+			TextContains: "veggies 1",
+			SortBy:       "created_at",
+			Limit:        100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{todos[1].TodoID}, listTodoIDs(rows))
+	})
+}
+
+func listTodoIDs(rows []sqlc.TodoappTodo) []string {
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.TodoID)
+	}
+	return ids
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	for _, todo := range []string{"buy carrots", "buy carrots and more carrots", "walk the dog"} {
+		_, err := q.Create(ctx, sqlc.CreateParams{
+			UserID: userID,
+			Todo:   todo,
+		})
+		require.NoError(t, err)
+	}
+
+	// Another user's todos are never returned.
+	_, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: uuid.NewString(),
+		Todo:   "buy carrots",
+	})
+	require.NoError(t, err)
+
+	rows, err := q.Search(ctx, sqlc.SearchParams{
+		UserID: userID,
+		Query:  "carrot",
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "buy carrots and more carrots", rows[0].TodoappTodo.Todo)
+	require.Greater(t, rows[0].Rank, rows[1].Rank)
+	require.Contains(t, rows[1].Snippet, "<b>carrots</b>")
 }
 
 func TestUpdate(t *testing.T) {
@@ -128,10 +299,11 @@ func TestUpdate(t *testing.T) {
 	userID := uuid.NewString()
 
 	t.Run("updateFailsWhenIDDoesNotExist", func(t *testing.T) {
-		_, err := q.Update(ctx, sqlc.UpdateParams{
+		todo := aTodo
+		_, err := UpdateTodo(ctx, pool, UpdateTodoParams{
 			UserID: userID,
 			TodoID: uuid.NewString(),
-			Todo:   aTodo,
+			Todo:   &todo,
 		})
 		require.ErrorIs(t, err, pgx.ErrNoRows)
 	})
@@ -146,16 +318,220 @@ func TestUpdate(t *testing.T) {
 		time.Sleep(time.Millisecond) // just in case
 
 		newTodo := "get some sleep"
-		updatedTodo, err := q.Update(ctx, sqlc.UpdateParams{
+		updatedTodo, err := UpdateTodo(ctx, pool, UpdateTodoParams{
 			UserID: userID,
 			TodoID: post.TodoID,
-			Todo:   newTodo,
+			Todo:   &newTodo,
 		})
 		require.NoError(t, err)
 
 		require.Equal(t, updatedTodo.Todo, newTodo, "got '%s', want '%s'")
 		require.True(t, post.CreatedAt.Time.Before(updatedTodo.UpdatedAt.Time))
 	})
+
+	t.Run("updateOnlyChangesGivenFields", func(t *testing.T) {
+		dueAt := pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true}
+		post, err := q.Create(ctx, sqlc.CreateParams{
+			UserID: userID,
+			Todo:   aTodo,
+			DueAt:  dueAt,
+		})
+		require.NoError(t, err)
+
+		newDueAt := pgtype.Timestamptz{}
+		updatedTodo, err := UpdateTodo(ctx, pool, UpdateTodoParams{
+			UserID: userID,
+			TodoID: post.TodoID,
+			DueAt:  &newDueAt,
+		})
+		require.NoError(t, err)
+		require.Equal(t, aTodo, updatedTodo.Todo)
+		require.False(t, updatedTodo.DueAt.Valid)
+	})
+}
+
+func TestCompleteAndReopen(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	todo, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+	require.False(t, todo.Completed)
+	require.False(t, todo.CompletedAt.Valid)
+
+	completed, err := q.Complete(ctx, sqlc.CompleteParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+	require.True(t, completed.Completed)
+	require.True(t, completed.CompletedAt.Valid)
+
+	// Completing twice keeps the original completion time.
+	again, err := q.Complete(ctx, sqlc.CompleteParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+	require.True(t, completed.CompletedAt.Time.Equal(again.CompletedAt.Time))
+
+	reopened, err := q.Reopen(ctx, sqlc.ReopenParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+	require.False(t, reopened.Completed)
+	require.False(t, reopened.CompletedAt.Valid)
+}
+
+func TestTags(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	todo, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+
+	other, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+
+	names := []string{"groceries", "weekend"}
+	err = q.CreateTags(ctx, sqlc.CreateTagsParams{
+		UserID: userID,
+		Names:  names,
+	})
+	require.NoError(t, err)
+
+	for _, todoID := range []string{todo.TodoID, other.TodoID} {
+		err = q.AddTags(ctx, sqlc.AddTagsParams{
+			UserID: userID,
+			TodoID: todoID,
+			Names:  names[:1],
+		})
+		require.NoError(t, err)
+	}
+
+	err = q.AddTags(ctx, sqlc.AddTagsParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+		Names:  names,
+	})
+	require.NoError(t, err)
+
+	tags, err := q.ReadTodoTags(ctx, sqlc.ReadTodoTagsParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, names, tags)
+
+	counts, err := q.ListTags(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, []sqlc.ListTagsRow{
+		{Name: "groceries", TodoCount: 2},
+		{Name: "weekend", TodoCount: 1},
+	}, counts)
+
+	rows, err := q.ReadPage(ctx, sqlc.ReadPageParams{
+		UserID: userID,
+		Tag:    pgtype.Text{String: "weekend", Valid: true},
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, todo.TodoID, rows[0].TodoID)
+
+	err = q.RemoveTags(ctx, sqlc.RemoveTagsParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+		Names:  names,
+	})
+	require.NoError(t, err)
+
+	err = q.DeleteUnusedTags(ctx, sqlc.DeleteUnusedTagsParams{
+		UserID: userID,
+		Names:  names,
+	})
+	require.NoError(t, err)
+
+	// "groceries" is still on the other todo, so only "weekend" goes away.
+	counts, err = q.ListTags(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, []sqlc.ListTagsRow{{Name: "groceries", TodoCount: 1}}, counts)
+}
+
+func TestLists(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	list, err := q.CreateList(ctx, sqlc.CreateListParams{
+		UserID: userID,
+		Name:   "groceries",
+	})
+	require.NoError(t, err)
+
+	other, err := q.CreateList(ctx, sqlc.CreateListParams{
+		UserID: userID,
+		Name:   "chores",
+	})
+	require.NoError(t, err)
+
+	todo, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+		ListID: pgtype.Text{String: list.ListID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, list.ListID, todo.ListID.String)
+
+	moved, err := q.MoveToList(ctx, sqlc.MoveToListParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+		ListID: pgtype.Text{String: other.ListID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, other.ListID, moved.ListID.String)
+
+	rows, err := q.ReadPage(ctx, sqlc.ReadPageParams{
+		UserID: userID,
+		ListID: pgtype.Text{String: other.ListID, Valid: true},
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	// A todo cannot be moved into another user's list.
+	_, err = q.MoveToList(ctx, sqlc.MoveToListParams{
+		UserID: uuid.NewString(),
+		TodoID: todo.TodoID,
+		ListID: pgtype.Text{String: list.ListID, Valid: true},
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	err = q.DeleteList(ctx, sqlc.DeleteListParams{
+		UserID: userID,
+		ListID: other.ListID,
+	})
+	require.NoError(t, err)
+
+	_, err = q.Read(ctx, sqlc.ReadParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	lists, err := q.ReadAllLists(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	require.Equal(t, list.ListID, lists[0].ListID)
 }
 
 func TestDelete(t *testing.T) {
@@ -168,11 +544,12 @@ func TestDelete(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	err = q.Delete(ctx, sqlc.DeleteParams{
+	n, err := q.Delete(ctx, sqlc.DeleteParams{
 		UserID: userID,
 		TodoID: todo.TodoID,
 	})
 	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
 
 	// Now try to read the deleted post; it should not exist.
 	_, err = q.Read(ctx, sqlc.ReadParams{
@@ -185,9 +562,542 @@ func TestDelete(t *testing.T) {
 func TestDeleteNotExists(t *testing.T) {
 	ctx := context.Background()
 
-	err := q.Delete(ctx, sqlc.DeleteParams{
+	n, err := q.Delete(ctx, sqlc.DeleteParams{
 		UserID: "foo",
 		TodoID: uuid.NewString(),
 	})
 	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestTrash(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	todo, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+
+	n, err := q.Delete(ctx, sqlc.DeleteParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+
+	trash, err := q.ReadTrashPage(ctx, sqlc.ReadTrashPageParams{
+		UserID: userID,
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	require.True(t, trash[0].DeletedAt.Valid)
+
+	restored, err := q.Restore(ctx, sqlc.RestoreParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+	require.False(t, restored.DeletedAt.Valid)
+
+	_, err = q.Delete(ctx, sqlc.DeleteParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+
+	// Nothing has been in the trash for an hour yet.
+	n, err = q.PurgeExpiredTrash(ctx, pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true})
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	n, err = q.PurgeTrash(ctx, userID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+
+	_, err = q.Restore(ctx, sqlc.RestoreParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+	key := uuid.NewString()
+
+	_, err := q.ReadIdempotencyKey(ctx, sqlc.ReadIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	_, err = q.CreateIdempotencyKey(ctx, sqlc.CreateIdempotencyKeyParams{
+		UserID:      userID,
+		Key:         key,
+		Response:    []byte("first"),
+		ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		RequestHash: []byte("hash"),
+	})
+	require.NoError(t, err)
+
+	// An unexpired key is not overwritten.
+	_, err = q.CreateIdempotencyKey(ctx, sqlc.CreateIdempotencyKeyParams{
+		UserID:    userID,
+		Key:       key,
+		Response:  []byte("second"),
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	row, err := q.ReadIdempotencyKey(ctx, sqlc.ReadIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), row.Response)
+	require.Equal(t, []byte("hash"), row.RequestHash)
+
+	// Keys are per user.
+	_, err = q.ReadIdempotencyKey(ctx, sqlc.ReadIdempotencyKeyParams{
+		UserID: uuid.NewString(),
+		Key:    key,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestVersion(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	todo, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, todo.Version)
+
+	newTodo := "get some sleep"
+	updated, err := UpdateTodo(ctx, pool, UpdateTodoParams{
+		UserID:          userID,
+		TodoID:          todo.TodoID,
+		ExpectedVersion: todo.Version,
+		Todo:            &newTodo,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, updated.Version)
+
+	// Any update bumps the version, not just Update.
+	completed, err := q.Complete(ctx, sqlc.CompleteParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, completed.Version)
+
+	_, err = UpdateTodo(ctx, pool, UpdateTodoParams{
+		UserID:          userID,
+		TodoID:          todo.TodoID,
+		ExpectedVersion: updated.Version,
+		Todo:            &newTodo,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	n, err := q.Delete(ctx, sqlc.DeleteParams{
+		UserID:          userID,
+		TodoID:          todo.TodoID,
+		ExpectedVersion: updated.Version,
+	})
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	n, err = q.Delete(ctx, sqlc.DeleteParams{
+		UserID:          userID,
+		TodoID:          todo.TodoID,
+		ExpectedVersion: completed.Version,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+}
+
+func TestTodoEvents(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	todo, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+
+	newTodo := "buy some fruit"
+	_, err = UpdateTodo(ctx, pool, UpdateTodoParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+		Todo:   &newTodo,
+	})
+	require.NoError(t, err)
+
+	_, err = q.Delete(ctx, sqlc.DeleteParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+
+	_, err = q.Restore(ctx, sqlc.RestoreParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+
+	_, err = q.Delete(ctx, sqlc.DeleteParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+	})
+	require.NoError(t, err)
+
+	// Purging from the trash is not another delete.
+	_, err = q.PurgeTrash(ctx, userID)
+	require.NoError(t, err)
+
+	events, err := q.ReadTodoEvents(ctx, sqlc.ReadTodoEventsParams{
+		UserID: userID,
+		Limit:  100,
+	})
+	require.NoError(t, err)
+
+	var kinds []string
+	for _, event := range events {
+		require.Equal(t, todo.TodoID, event.TodoID)
+		kinds = append(kinds, event.Kind)
+	}
+	require.Equal(t, []string{"created", "updated", "deleted", "created", "deleted"}, kinds)
+
+	// Events are numbered by the user's change sequence.
+	for i := 1; i < len(events); i++ {
+		require.Greater(t, events[i].Seq, events[i-1].Seq)
+	}
+
+	latest, err := q.ReadLatestTodoEventSeq(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, events[len(events)-1].Seq, latest)
+
+	events, err = q.ReadTodoEvents(ctx, sqlc.ReadTodoEventsParams{
+		UserID: userID,
+		Seq:    latest,
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestChangeSeq(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	todo1, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, todo1.ChangeSeq)
+
+	todo2, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, todo2.ChangeSeq)
+
+	// Another user has their own sequence.
+	other, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: uuid.NewString(),
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, other.ChangeSeq)
+
+	completed, err := q.Complete(ctx, sqlc.CompleteParams{
+		UserID: userID,
+		TodoID: todo1.TodoID,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, completed.ChangeSeq)
+
+	todos, err := q.ReadTodoChanges(ctx, sqlc.ReadTodoChangesParams{
+		UserID:    userID,
+		ChangeSeq: 1,
+		Limit:     100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{todo2.TodoID, todo1.TodoID}, listTodoIDs(todos))
+
+	_, err = q.Delete(ctx, sqlc.DeleteParams{
+		UserID: userID,
+		TodoID: todo2.TodoID,
+	})
+	require.NoError(t, err)
+
+	_, err = q.PurgeTrash(ctx, userID)
+	require.NoError(t, err)
+
+	tombstones, err := q.ReadTombstones(ctx, sqlc.ReadTombstonesParams{
+		UserID: userID,
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Len(t, tombstones, 1)
+	require.Equal(t, todo2.TodoID, tombstones[0].TodoID)
+	require.EqualValues(t, 5, tombstones[0].ChangeSeq)
+}
+
+func TestRevisions(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	todo, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+
+	newTodo := "buy some fruit"
+	_, err = UpdateTodo(ctx, pool, UpdateTodoParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+		Todo:   &newTodo,
+	})
+	require.NoError(t, err)
+
+	// Setting the same text again is not a revision.
+	_, err = UpdateTodo(ctx, pool, UpdateTodoParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+		Todo:   &newTodo,
+	})
+	require.NoError(t, err)
+
+	revisions, err := q.ReadRevisionPage(ctx, sqlc.ReadRevisionPageParams{
+		UserID: userID,
+		TodoID: todo.TodoID,
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, aTodo, revisions[0].Todo)
+	require.EqualValues(t, 1, revisions[0].Version)
+	require.Equal(t, newTodo, revisions[1].Todo)
+	require.EqualValues(t, 2, revisions[1].Version)
+
+	revision, err := q.ReadRevision(ctx, sqlc.ReadRevisionParams{
+		UserID:  userID,
+		TodoID:  todo.TodoID,
+		Version: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, aTodo, revision.Todo)
+
+	_, err = q.ReadRevision(ctx, sqlc.ReadRevisionParams{
+		UserID:  userID,
+		TodoID:  todo.TodoID,
+		Version: 3,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestSubtasks(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	parent, err := q.Create(ctx, sqlc.CreateParams{
+		UserID: userID,
+		Todo:   aTodo,
+	})
+	require.NoError(t, err)
+
+	child, err := q.Create(ctx, sqlc.CreateParams{
+		UserID:       userID,
+		Todo:         aTodo,
+		ParentTodoID: pgtype.Text{String: parent.TodoID, Valid: true},
+	})
+	require.NoError(t, err)
+
+	_, err = q.Complete(ctx, sqlc.CompleteParams{
+		UserID: userID,
+		TodoID: child.TodoID,
+	})
+	require.NoError(t, err)
+
+	read, err := q.Read(ctx, sqlc.ReadParams{
+		UserID: userID,
+		TodoID: parent.TodoID,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, read.ChildCount)
+	require.EqualValues(t, 1, read.CompletedChildCount)
+
+	// Counting subtasks is not a change to the parent.
+	require.Equal(t, parent.Version, read.Version)
+
+	t.Run("parent must belong to the same user", func(t *testing.T) {
+		_, err := q.Create(ctx, sqlc.CreateParams{
+			UserID:       uuid.NewString(),
+			Todo:         aTodo,
+			ParentTodoID: pgtype.Text{String: parent.TodoID, Valid: true},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("prevents cycles", func(t *testing.T) {
+		parentTodoID := pgtype.Text{String: child.TodoID, Valid: true}
+		_, err := UpdateTodo(ctx, pool, UpdateTodoParams{
+			UserID:       userID,
+			TodoID:       parent.TodoID,
+			ParentTodoID: &parentTodoID,
+		})
+		var pgErr *pgconn.PgError
+		require.ErrorAs(t, err, &pgErr)
+		require.Equal(t, "todo_parent_cycle", pgErr.ConstraintName)
+	})
+
+	t.Run("reads the tree", func(t *testing.T) {
+		rows, err := q.ReadTree(ctx, sqlc.ReadTreeParams{
+			UserID: userID,
+			TodoID: parent.TodoID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{parent.TodoID, child.TodoID}, listTodoIDs(rows))
+	})
+
+	t.Run("trashed subtasks are not counted", func(t *testing.T) {
+		_, err := q.Delete(ctx, sqlc.DeleteParams{
+			UserID: userID,
+			TodoID: child.TodoID,
+		})
+		require.NoError(t, err)
+
+		read, err := q.Read(ctx, sqlc.ReadParams{
+			UserID: userID,
+			TodoID: parent.TodoID,
+		})
+		require.NoError(t, err)
+		require.Zero(t, read.ChildCount)
+		require.Zero(t, read.CompletedChildCount)
+	})
+}
+
+func TestPosition(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	var todos []sqlc.TodoappTodo
+	for i := 0; i < 3; i++ {
+		todo, err := q.Create(ctx, sqlc.CreateParams{
+			UserID: userID,
+			Todo:   aTodo,
+		})
+		require.NoError(t, err)
+		todos = append(todos, todo)
+	}
+
+	require.Less(t, todos[0].Position, todos[1].Position)
+	require.Less(t, todos[1].Position, todos[2].Position)
+
+	after, err := q.ReadPositionAfter(ctx, sqlc.ReadPositionAfterParams{
+		UserID:   userID,
+		TodoID:   todos[1].TodoID,
+		Position: todos[0].Position,
+		ID:       todos[0].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, todos[2].Position, after)
+
+	before, err := q.ReadPositionBefore(ctx, sqlc.ReadPositionBeforeParams{
+		UserID:   userID,
+		TodoID:   todos[2].TodoID,
+		Position: todos[0].Position,
+		ID:       todos[0].ID,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	require.Empty(t, before)
+
+	moved, err := q.SetPosition(ctx, sqlc.SetPositionParams{
+		UserID:   userID,
+		TodoID:   todos[2].TodoID,
+		Position: "0V",
+	})
+	require.NoError(t, err)
+
+	rows, err := q.List(ctx, sqlc.ListParams{
+		UserID: userID,
+		SortBy: "position",
+		Limit:  100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{moved.TodoID, todos[0].TodoID, todos[1].TodoID}, listTodoIDs(rows))
+
+	t.Run("renumbering is not a change", func(t *testing.T) {
+		err := q.RenumberPositions(ctx, sqlc.RenumberPositionsParams{UserID: userID})
+		require.NoError(t, err)
+
+		rows, err := q.List(ctx, sqlc.ListParams{
+			UserID: userID,
+			SortBy: "position",
+			Limit:  100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{moved.TodoID, todos[0].TodoID, todos[1].TodoID}, listTodoIDs(rows))
+		require.Equal(t, todos[1].Version, rows[2].Version)
+		require.Equal(t, todos[1].ChangeSeq, rows[2].ChangeSeq)
+	})
+
+	t.Run("appending renumbers long positions", func(t *testing.T) {
+		_, err := q.SetPosition(ctx, sqlc.SetPositionParams{
+			UserID:   userID,
+			TodoID:   todos[1].TodoID,
+			Position: strings.Repeat("z", 51),
+		})
+		require.NoError(t, err)
+
+		last, err := q.Create(ctx, sqlc.CreateParams{
+			UserID: userID,
+			Todo:   aTodo,
+		})
+		require.NoError(t, err)
+		require.Less(t, len(last.Position), 50)
+
+		rows, err := q.List(ctx, sqlc.ListParams{
+			UserID: userID,
+			SortBy: "position",
+			Limit:  100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{moved.TodoID, todos[0].TodoID, todos[1].TodoID, last.TodoID}, listTodoIDs(rows))
+	})
+
+	t.Run("moving to another list goes to the end", func(t *testing.T) {
+		list, err := q.CreateList(ctx, sqlc.CreateListParams{
+			UserID: userID,
+			Name:   "chores",
+		})
+		require.NoError(t, err)
+
+		first, err := q.Create(ctx, sqlc.CreateParams{
+			UserID: userID,
+			Todo:   aTodo,
+			ListID: pgtype.Text{String: list.ListID, Valid: true},
+		})
+		require.NoError(t, err)
+
+		moved, err := q.MoveToList(ctx, sqlc.MoveToListParams{
+			ListID: pgtype.Text{String: list.ListID, Valid: true},
+			UserID: userID,
+			TodoID: todos[0].TodoID,
+		})
+		require.NoError(t, err)
+		require.Less(t, first.Position, moved.Position)
+	})
 }
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UpdateTodoParams are the arguments to UpdateTodo. Only the non-nil fields
+// are changed.
+type UpdateTodoParams struct {
+	UserID string
+	TodoID string
+
+	// If non-zero, the update only happens if the todo is at this version.
+	ExpectedVersion int64
+
+	Todo         *string
+	DueAt        *pgtype.Timestamptz
+	ParentTodoID *pgtype.Text
+	RRule        *pgtype.Text
+	RemindAt     *pgtype.Timestamptz
+	Priority     *int16
+	AssigneeID   *pgtype.Text
+}
+
+// UpdateTodo changes some of a todo's columns. sqlc can only generate queries
+// that set a fixed list of columns, hence this is built by hand. It returns
+// pgx.ErrNoRows if no todo matched.
+func UpdateTodo(ctx context.Context, db sqlc.DBTX, arg UpdateTodoParams) (sqlc.TodoappTodo, error) {
+	sets := []string{"updated_at = now()"}
+	var args []any
+
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if arg.Todo != nil {
+		set("todo", *arg.Todo)
+	}
+	if arg.DueAt != nil {
+		set("due_at", *arg.DueAt)
+	}
+	if arg.ParentTodoID != nil {
+		set("parent_todo_id", *arg.ParentTodoID)
+	}
+	if arg.RRule != nil {
+		set("rrule", *arg.RRule)
+	}
+	if arg.RemindAt != nil {
+		set("remind_at", *arg.RemindAt)
+	}
+	if arg.Priority != nil {
+		set("priority", *arg.Priority)
+	}
+	if arg.AssigneeID != nil {
+		set("assignee_id", *arg.AssigneeID)
+	}
+
+	args = append(args, arg.UserID, arg.TodoID, arg.ExpectedVersion)
+	n := len(args)
+
+	query := fmt.Sprintf(`update todoapp.todo
+set %s
+where user_id = $%d and todo_id = $%d and deleted_at is null
+and ($%d::bigint = 0 or version = $%d)
+returning *`, strings.Join(sets, ", "), n-2, n-1, n, n)
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return sqlc.TodoappTodo{}, err
+	}
+
+	return pgx.CollectOneRow(rows, pgx.RowToStructByPos[sqlc.TodoappTodo])
+}
@@ -0,0 +1,431 @@
+// Package rrule implements the subset of iCalendar recurrence rules (RFC 5545)
+// that recurring todos support: FREQ, INTERVAL, COUNT, UNTIL, BYDAY,
+// BYMONTHDAY, BYMONTH and WKST.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = map[string]Frequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+const untilLayout = "20060102T150405Z"
+
+// searchYears bounds how far ahead Next looks for an occurrence.
+const searchYears = 100
+
+var ErrNoFrequency = errors.New("rrule: FREQ is required")
+
+// WeekdayNum is a BYDAY value, such as MO, or 1MO for the first Monday of the
+// month. N is zero for every such weekday.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+type Rule struct {
+	Freq     Frequency
+	Interval int
+
+	// Count is the number of occurrences left, including the current one.
+	// Zero means no limit.
+	Count int
+
+	// Until is the last time an occurrence may fall on. Zero means no limit.
+	Until time.Time
+
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	WeekStart  time.Weekday
+}
+
+// Parse parses a rule such as "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR". An
+// "RRULE:" prefix is allowed.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "RRULE:")
+
+	r := &Rule{
+		Freq:      -1,
+		Interval:  1,
+		WeekStart: time.Monday,
+	}
+
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("rrule: malformed part %q", part)
+		}
+
+		if seen[name] {
+			return nil, fmt.Errorf("rrule: %s is given more than once", name)
+		}
+		seen[name] = true
+
+		if err := r.parsePart(name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Freq < 0 {
+		return nil, ErrNoFrequency
+	}
+
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, errors.New("rrule: COUNT and UNTIL cannot both be given")
+	}
+
+	for _, d := range r.ByDay {
+		if d.N != 0 && r.Freq != Monthly {
+			return nil, errors.New("rrule: numbered BYDAY values are only supported with FREQ=MONTHLY")
+		}
+	}
+
+	return r, nil
+}
+
+func (r *Rule) parsePart(name, value string) error {
+	switch name {
+	case "FREQ":
+		freq, ok := frequencies[value]
+		if !ok {
+			return fmt.Errorf("rrule: unsupported FREQ %q", value)
+		}
+		r.Freq = freq
+	case "INTERVAL":
+		n, err := parseInt(name, value, 1, 1000)
+		if err != nil {
+			return err
+		}
+		r.Interval = n
+	case "COUNT":
+		n, err := parseInt(name, value, 1, 1000)
+		if err != nil {
+			return err
+		}
+		r.Count = n
+	case "UNTIL":
+		until, err := parseUntil(value)
+		if err != nil {
+			return err
+		}
+		r.Until = until
+	case "BYDAY":
+		for _, v := range strings.Split(value, ",") {
+			d, err := parseWeekdayNum(v)
+			if err != nil {
+				return err
+			}
+			r.ByDay = append(r.ByDay, d)
+		}
+	case "BYMONTHDAY":
+		for _, v := range strings.Split(value, ",") {
+			n, err := parseInt(name, v, -31, 31)
+			if err != nil || n == 0 {
+				return fmt.Errorf("rrule: invalid BYMONTHDAY %q", v)
+			}
+			r.ByMonthDay = append(r.ByMonthDay, n)
+		}
+	case "BYMONTH":
+		for _, v := range strings.Split(value, ",") {
+			n, err := parseInt(name, v, 1, 12)
+			if err != nil {
+				return err
+			}
+			r.ByMonth = append(r.ByMonth, time.Month(n))
+		}
+	case "WKST":
+		weekday, ok := weekdays[value]
+		if !ok {
+			return fmt.Errorf("rrule: invalid WKST %q", value)
+		}
+		r.WeekStart = weekday
+	default:
+		return fmt.Errorf("rrule: unsupported part %q", name)
+	}
+
+	return nil
+}
+
+func parseInt(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("rrule: %s must be a number from %d to %d", name, min, max)
+	}
+
+	return n, nil
+}
+
+// parseUntil accepts a UTC date-time, or a date, which includes the whole
+// day.
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(untilLayout, value); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+
+	return time.Time{}, fmt.Errorf("rrule: invalid UNTIL %q", value)
+}
+
+func parseWeekdayNum(value string) (WeekdayNum, error) {
+	if len(value) < 2 {
+		return WeekdayNum{}, fmt.Errorf("rrule: invalid BYDAY %q", value)
+	}
+
+	weekday, ok := weekdays[value[len(value)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("rrule: invalid BYDAY %q", value)
+	}
+
+	d := WeekdayNum{Weekday: weekday}
+	if prefix := value[:len(value)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("rrule: invalid BYDAY %q", value)
+		}
+		d.N = n
+	}
+
+	return d, nil
+}
+
+// Next returns the first occurrence after dtstart, which is the current
+// occurrence and the start of the recurrence. Occurrences are at the same
+// time of day as dtstart, in UTC. It returns false if there are no more
+// occurrences.
+func (r *Rule) Next(dtstart time.Time) (time.Time, bool) {
+	if r.Count == 1 {
+		return time.Time{}, false
+	}
+
+	dtstart = dtstart.UTC()
+	end := dtstart.AddDate(searchYears, 0, 0)
+
+	for t := dtstart.AddDate(0, 0, 1); t.Before(end); t = t.AddDate(0, 0, 1) {
+		if !r.Until.IsZero() && t.After(r.Until) {
+			return time.Time{}, false
+		}
+
+		if r.inInterval(dtstart, t) && r.matches(dtstart, t) {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// inInterval reports whether t is in a period, such as a week, that the
+// interval includes.
+func (r *Rule) inInterval(dtstart, t time.Time) bool {
+	var periods int
+	switch r.Freq {
+	case Daily:
+		periods = days(t) - days(dtstart)
+	case Weekly:
+		periods = r.week(t) - r.week(dtstart)
+	case Monthly:
+		periods = (t.Year()-dtstart.Year())*12 + int(t.Month()-dtstart.Month())
+	case Yearly:
+		periods = t.Year() - dtstart.Year()
+	}
+
+	return periods%r.Interval == 0
+}
+
+// matches reports whether the day of t satisfies the BY parts, or, for those
+// that are not given, falls on the same day of the period as dtstart.
+func (r *Rule) matches(dtstart, t time.Time) bool {
+	if len(r.ByMonth) > 0 && !contains(r.ByMonth, t.Month()) {
+		return false
+	}
+
+	if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(t) {
+		return false
+	}
+
+	if len(r.ByDay) > 0 && !r.matchesDay(t) {
+		return false
+	}
+
+	switch r.Freq {
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return t.Weekday() == dtstart.Weekday()
+		}
+	case Monthly:
+		if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			return t.Day() == dtstart.Day()
+		}
+	case Yearly:
+		if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			if len(r.ByMonth) == 0 && t.Month() != dtstart.Month() {
+				return false
+			}
+			return t.Day() == dtstart.Day()
+		}
+	}
+
+	return true
+}
+
+func (r *Rule) matchesMonthDay(t time.Time) bool {
+	last := daysIn(t)
+	for _, n := range r.ByMonthDay {
+		if n == t.Day() || n < 0 && last+n+1 == t.Day() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Rule) matchesDay(t time.Time) bool {
+	for _, d := range r.ByDay {
+		if d.Weekday != t.Weekday() {
+			continue
+		}
+
+		switch {
+		case d.N == 0:
+			return true
+		case d.N > 0 && (t.Day()-1)/7+1 == d.N:
+			return true
+		case d.N < 0 && (daysIn(t)-t.Day())/7+1 == -d.N:
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the rule in canonical form, without the "RRULE:" prefix.
+func (r *Rule) String() string {
+	var freq string
+	for name, f := range frequencies {
+		if f == r.Freq {
+			freq = name
+		}
+	}
+
+	parts := []string{"FREQ=" + freq}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+
+	if len(r.ByDay) > 0 {
+		values := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			v := weekdayName(d.Weekday)
+			if d.N != 0 {
+				v = strconv.Itoa(d.N) + v
+			}
+			values = append(values, v)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(values, ","))
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		values := make([]string, 0, len(r.ByMonthDay))
+		for _, n := range r.ByMonthDay {
+			values = append(values, strconv.Itoa(n))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(values, ","))
+	}
+
+	if len(r.ByMonth) > 0 {
+		values := make([]string, 0, len(r.ByMonth))
+		for _, m := range r.ByMonth {
+			values = append(values, strconv.Itoa(int(m)))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(values, ","))
+	}
+
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayName(r.WeekStart))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func weekdayName(weekday time.Weekday) string {
+	for name, w := range weekdays {
+		if w == weekday {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// days returns the number of days from the Unix epoch to t's date.
+func days(t time.Time) int {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(date.Unix() / (24 * 60 * 60))
+}
+
+// week returns the index of t's week, where weeks begin on WeekStart.
+func (r *Rule) week(t time.Time) int {
+	// The Unix epoch was a Thursday.
+	offset := (int(time.Thursday) - int(r.WeekStart) + 7) % 7
+	d := days(t) + offset
+	if d < 0 {
+		return (d - 6) / 7
+	}
+
+	return d / 7
+}
+
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func contains[T comparable](s []T, v T) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
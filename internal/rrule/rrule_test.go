@@ -0,0 +1,136 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		rule    string
+		dtstart time.Time
+		want    []time.Time
+	}{
+		{
+			rule:    "FREQ=DAILY",
+			dtstart: date(2023, time.February, 27),
+			want:    []time.Time{date(2023, time.February, 28), date(2023, time.March, 1)},
+		},
+		{
+			// Every weekday, starting on a Friday.
+			rule:    "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+			dtstart: date(2023, time.June, 2),
+			want:    []time.Time{date(2023, time.June, 5), date(2023, time.June, 6)},
+		},
+		{
+			rule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			dtstart: date(2023, time.June, 5),
+			want:    []time.Time{date(2023, time.June, 7), date(2023, time.June, 19), date(2023, time.June, 21)},
+		},
+		{
+			rule:    "FREQ=WEEKLY",
+			dtstart: date(2023, time.June, 7),
+			want:    []time.Time{date(2023, time.June, 14)},
+		},
+		{
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=1",
+			dtstart: date(2023, time.January, 15),
+			want:    []time.Time{date(2023, time.February, 1), date(2023, time.March, 1)},
+		},
+		{
+			// Months without a 31st are skipped.
+			rule:    "FREQ=MONTHLY",
+			dtstart: date(2023, time.January, 31),
+			want:    []time.Time{date(2023, time.March, 31), date(2023, time.May, 31)},
+		},
+		{
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart: date(2024, time.January, 31),
+			want:    []time.Time{date(2024, time.February, 29), date(2024, time.March, 31)},
+		},
+		{
+			// The last Friday of the month.
+			rule:    "FREQ=MONTHLY;BYDAY=-1FR",
+			dtstart: date(2023, time.June, 30),
+			want:    []time.Time{date(2023, time.July, 28), date(2023, time.August, 25)},
+		},
+		{
+			rule:    "FREQ=MONTHLY;INTERVAL=3;BYDAY=2TU",
+			dtstart: date(2023, time.January, 10),
+			want:    []time.Time{date(2023, time.April, 11)},
+		},
+		{
+			rule:    "FREQ=YEARLY",
+			dtstart: date(2024, time.February, 29),
+			want:    []time.Time{date(2028, time.February, 29)},
+		},
+		{
+			rule:    "FREQ=YEARLY;BYMONTH=3,9",
+			dtstart: date(2023, time.March, 15),
+			want:    []time.Time{date(2023, time.September, 15), date(2024, time.March, 15)},
+		},
+		{
+			rule:    "FREQ=DAILY;UNTIL=20230103",
+			dtstart: date(2023, time.January, 1),
+			want:    []time.Time{date(2023, time.January, 2), date(2023, time.January, 3)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rule, func(t *testing.T) {
+			rule, err := Parse(test.rule)
+			require.NoError(t, err)
+
+			dtstart := test.dtstart
+			for _, want := range test.want {
+				next, ok := rule.Next(dtstart)
+				require.True(t, ok)
+				require.Equal(t, want, next)
+				dtstart = next
+			}
+		})
+	}
+}
+
+func TestNextEnds(t *testing.T) {
+	rule, err := Parse("FREQ=DAILY;UNTIL=20230102T000000Z")
+	require.NoError(t, err)
+
+	_, ok := rule.Next(date(2023, time.January, 1))
+	require.False(t, ok)
+
+	rule, err = Parse("FREQ=DAILY;COUNT=1")
+	require.NoError(t, err)
+
+	_, ok = rule.Next(date(2023, time.January, 1))
+	require.False(t, ok)
+}
+
+func TestParse(t *testing.T) {
+	rule, err := Parse("RRULE:freq=monthly;interval=2;byday=1mo,-1fr;wkst=su")
+	require.NoError(t, err)
+	require.Equal(t, "FREQ=MONTHLY;INTERVAL=2;BYDAY=1MO,-1FR;WKST=SU", rule.String())
+
+	for _, s := range []string{
+		"",
+		"BYDAY=MO",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20230101",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ=DAILY;UNTIL=tomorrow",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/craigpastro/todoapp/internal/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrAssigneeCannotSeeTodo = errors.New("the assignee cannot see the todo")
+
+// Assign sets or clears the todo's assignee and records who made the change.
+// Only users who can edit the todo can assign it, and only to its owner or to
+// users it is shared with. Assignments are not undoable.
+func (s *server) Assign(ctx context.Context, req *connect.Request[pb.AssignRequest]) (*connect.Response[pb.AssignResponse], error) {
+	ctx, span := tracer.Start(ctx, "Assign")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+	todoID := msg.GetTodoId()
+
+	var row sqlc.TodoappTodo
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		ownerID, err := todoOwner(ctx, span, q, userID, todoID, true)
+		if err != nil {
+			return err
+		}
+
+		assigneeID := newText(msg.GetAssigneeId())
+		if assigneeID.Valid {
+			role, err := q.ReadTodoRole(ctx, sqlc.ReadTodoRoleParams{
+				UserID: assigneeID.String,
+				TodoID: todoID,
+			})
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				instrumentation.TraceError(span, err)
+				return newInternalError(err)
+			}
+
+			if err != nil || role.OwnerID != ownerID {
+				return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrAssigneeCannotSeeTodo))
+			}
+		}
+
+		row, err = postgres.UpdateTodo(ctx, tx, postgres.UpdateTodoParams{
+			UserID:          ownerID,
+			TodoID:          todoID,
+			ExpectedVersion: msg.GetExpectedVersion(),
+			AssigneeID:      &assigneeID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return noRowsError(ctx, span, q, ownerID, todoID, msg.GetExpectedVersion())
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		if _, err := q.CreateAssignment(ctx, sqlc.CreateAssignmentParams{
+			UserID:     ownerID,
+			TodoID:     todoID,
+			AssigneeID: assigneeID,
+			AssignedBy: userID,
+		}); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.AssignResponse{
+		Todo: newReadResponse(row),
+	}), nil
+}
+
+// ListAssigned returns the todos assigned to the user that they can still
+// see, whoever owns them.
+func (s *server) ListAssigned(ctx context.Context, req *connect.Request[pb.ListAssignedRequest]) (*connect.Response[pb.ListAssignedResponse], error) {
+	ctx, span := tracer.Start(ctx, "ListAssigned")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	afterID, err := decodePageToken(msg.GetPageToken())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	size := pageSize(msg.GetPageSize())
+
+	params := sqlc.ReadAssignedTodosParams{
+		UserID: userID,
+		ID:     afterID,
+		Limit:  size + 1,
+	}
+	if msg.Completed != nil {
+		params.Completed = pgtype.Bool{Bool: msg.GetCompleted(), Valid: true}
+	}
+
+	// Fetch one extra row so we know whether there is another page.
+	rows, err := s.queries.ReadAssignedTodos(ctx, params)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	var nextPageToken string
+	if len(rows) > int(size) {
+		rows = rows[:size]
+		nextPageToken = encodePageToken(rows[len(rows)-1].TodoappTodo.ID)
+	}
+
+	todos := make([]*pb.AssignedTodo, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, &pb.AssignedTodo{
+			Todo:       newReadResponse(row.TodoappTodo),
+			AssignedBy: row.AssignedBy,
+			AssignedAt: timestamppb.New(row.AssignedAt.Time),
+		})
+	}
+
+	return connect.NewResponse(&pb.ListAssignedResponse{
+		Todos:         todos,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// ListAssignments returns the history of a todo's assignments, oldest first.
+// Anyone who can see the todo can list them.
+func (s *server) ListAssignments(ctx context.Context, req *connect.Request[pb.ListAssignmentsRequest]) (*connect.Response[pb.ListAssignmentsResponse], error) {
+	ctx, span := tracer.Start(ctx, "ListAssignments")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	afterID, err := decodePageToken(msg.GetPageToken())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	ownerID, err := todoOwner(ctx, span, s.queries, userID, msg.GetTodoId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queries.Read(ctx, sqlc.ReadParams{
+		UserID: ownerID,
+		TodoID: msg.GetTodoId(),
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	size := pageSize(msg.GetPageSize())
+
+	// Fetch one extra row so we know whether there is another page.
+	rows, err := s.queries.ReadAssignmentPage(ctx, sqlc.ReadAssignmentPageParams{
+		UserID: ownerID,
+		TodoID: msg.GetTodoId(),
+		ID:     afterID,
+		Limit:  size + 1,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	var nextPageToken string
+	if len(rows) > int(size) {
+		rows = rows[:size]
+		nextPageToken = encodePageToken(rows[len(rows)-1].ID)
+	}
+
+	assignments := make([]*pb.Assignment, 0, len(rows))
+	for _, row := range rows {
+		assignments = append(assignments, &pb.Assignment{
+			TodoId:     row.TodoID,
+			AssigneeId: row.AssigneeID.String,
+			AssignedBy: row.AssignedBy,
+			CreatedAt:  timestamppb.New(row.CreatedAt.Time),
+		})
+	}
+
+	return connect.NewResponse(&pb.ListAssignmentsResponse{
+		Assignments:   assignments,
+		NextPageToken: nextPageToken,
+	}), nil
+}
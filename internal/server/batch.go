@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *server) BatchCreate(ctx context.Context, req *connect.Request[pb.BatchCreateRequest]) (*connect.Response[pb.BatchCreateResponse], error) {
+	ctx, span := tracer.Start(ctx, "BatchCreate")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	todos := make([]*pb.CreateResponse, 0, len(req.Msg.GetTodos()))
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+		for i, item := range req.Msg.GetTodos() {
+			res, err := s.create(ctx, span, q, userID, item)
+			if err != nil {
+				return batchItemError(i, err)
+			}
+
+			todos = append(todos, res)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.BatchCreateResponse{
+		Todos: todos,
+	}), nil
+}
+
+func (s *server) BatchUpdate(ctx context.Context, req *connect.Request[pb.BatchUpdateRequest]) (*connect.Response[pb.BatchUpdateResponse], error) {
+	ctx, span := tracer.Start(ctx, "BatchUpdate")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	todos := make([]*pb.UpdateResponse, 0, len(req.Msg.GetTodos()))
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		for i, item := range req.Msg.GetTodos() {
+			res, err := s.update(ctx, span, tx, userID, item)
+			if err != nil {
+				return batchItemError(i, err)
+			}
+
+			todos = append(todos, res)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.BatchUpdateResponse{
+		Todos: todos,
+	}), nil
+}
+
+func (s *server) BatchDelete(ctx context.Context, req *connect.Request[pb.BatchDeleteRequest]) (*connect.Response[pb.BatchDeleteResponse], error) {
+	ctx, span := tracer.Start(ctx, "BatchDelete")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	todos := make([]*pb.DeleteResponse, 0, len(req.Msg.GetTodos()))
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+		for i, item := range req.Msg.GetTodos() {
+			if err := s.delete(ctx, span, q, userID, item); err != nil {
+				return batchItemError(i, err)
+			}
+
+			todos = append(todos, &pb.DeleteResponse{})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.BatchDeleteResponse{
+		Todos: todos,
+	}), nil
+}
+
+func (s *server) inTx(ctx context.Context, span trace.Span, fn func(tx pgx.Tx) error) error {
+	return runInTx(ctx, span, s.pool, fn)
+}
+
+// runInTx runs fn in a transaction, which is committed only if fn succeeds.
+// Errors from fn are returned as is.
+func runInTx(ctx context.Context, span trace.Span, pool *pgxpool.Pool, fn func(tx pgx.Tx) error) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return newInternalError(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		instrumentation.TraceError(span, err)
+		return newInternalError(err)
+	}
+
+	return nil
+}
+
+// batchItemError prefixes a public error with the index of the batch item
+// that caused it. Internal errors are left alone.
+func batchItemError(i int, err error) error {
+	var connectErr *connect.Error
+	var serverErr *ServerError
+	if !errors.As(err, &serverErr) || serverErr.Internal != nil || !errors.As(serverErr.Public, &connectErr) {
+		return err
+	}
+
+	return newPublicError(connect.NewError(connectErr.Code(), fmt.Errorf("todos[%d]: %w", i, connectErr.Unwrap())))
+}
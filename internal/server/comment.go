@@ -0,0 +1,236 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/gen/todoapp/v1/todoappv1connect"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrCommentDoesNotExist = errors.New("comment does not exist")
+	ErrNotCommentAuthor    = errors.New("only the author of a comment can change it")
+)
+
+type commentServer struct {
+	todoappv1connect.UnimplementedCommentServiceHandler
+
+	queries *sqlc.Queries
+}
+
+func NewCommentServer(pool *pgxpool.Pool) *commentServer {
+	return &commentServer{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (s *commentServer) AddComment(ctx context.Context, req *connect.Request[pb.AddCommentRequest]) (*connect.Response[pb.AddCommentResponse], error) {
+	ctx, span := tracer.Start(ctx, "AddComment")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+	todoID := msg.GetTodoId()
+
+	ownerID, err := todoOwner(ctx, span, s.queries, userID, todoID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Todos in the trash cannot be commented on.
+	if _, err := s.queries.Read(ctx, sqlc.ReadParams{
+		UserID: ownerID,
+		TodoID: todoID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	row, err := s.queries.AddComment(ctx, sqlc.AddCommentParams{
+		OwnerID: ownerID,
+		TodoID:  todoID,
+		UserID:  userID,
+		Body:    msg.GetBody(),
+	})
+	if err != nil {
+		// The todo was purged since it was read.
+		if isForeignKeyViolation(err, commentTodoForeignKey) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.AddCommentResponse{
+		Comment: newComment(row),
+	}), nil
+}
+
+func (s *commentServer) ListComments(ctx context.Context, req *connect.Request[pb.ListCommentsRequest]) (*connect.Response[pb.ListCommentsResponse], error) {
+	ctx, span := tracer.Start(ctx, "ListComments")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	afterID, err := decodePageToken(msg.GetPageToken())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	ownerID, err := todoOwner(ctx, span, s.queries, userID, msg.GetTodoId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queries.Read(ctx, sqlc.ReadParams{
+		UserID: ownerID,
+		TodoID: msg.GetTodoId(),
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	size := pageSize(msg.GetPageSize())
+
+	// Fetch one extra row so we know whether there is another page.
+	rows, err := s.queries.ReadCommentPage(ctx, sqlc.ReadCommentPageParams{
+		OwnerID: ownerID,
+		TodoID:  msg.GetTodoId(),
+		ID:      afterID,
+		Limit:   size + 1,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	var nextPageToken string
+	if len(rows) > int(size) {
+		rows = rows[:size]
+		nextPageToken = encodePageToken(rows[len(rows)-1].ID)
+	}
+
+	comments := make([]*pb.Comment, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, newComment(row))
+	}
+
+	return connect.NewResponse(&pb.ListCommentsResponse{
+		Comments:      comments,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *commentServer) EditComment(ctx context.Context, req *connect.Request[pb.EditCommentRequest]) (*connect.Response[pb.EditCommentResponse], error) {
+	ctx, span := tracer.Start(ctx, "EditComment")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	comment, _, err := s.readComment(ctx, span, userID, msg.GetCommentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != userID {
+		return nil, newPublicError(connect.NewError(connect.CodePermissionDenied, ErrNotCommentAuthor))
+	}
+
+	row, err := s.queries.EditComment(ctx, sqlc.EditCommentParams{
+		CommentID: comment.CommentID,
+		Body:      msg.GetBody(),
+	})
+	if err != nil {
+		// The comment was deleted since it was read.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeNotFound, ErrCommentDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.EditCommentResponse{
+		Comment: newComment(row),
+	}), nil
+}
+
+func (s *commentServer) DeleteComment(ctx context.Context, req *connect.Request[pb.DeleteCommentRequest]) (*connect.Response[pb.DeleteCommentResponse], error) {
+	ctx, span := tracer.Start(ctx, "DeleteComment")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	comment, ownerID, err := s.readComment(ctx, span, userID, req.Msg.GetCommentId())
+	if err != nil {
+		return nil, err
+	}
+
+	// The todo's owner can also delete comments on it.
+	if comment.UserID != userID && ownerID != userID {
+		return nil, newPublicError(connect.NewError(connect.CodePermissionDenied, ErrNotCommentAuthor))
+	}
+
+	if err := s.queries.DeleteComment(ctx, comment.CommentID); err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteCommentResponse{}), nil
+}
+
+// readComment returns the comment and the id of its todo's owner, or
+// ErrCommentDoesNotExist if userID cannot read the todo.
+func (s *commentServer) readComment(ctx context.Context, span trace.Span, userID, commentID string) (sqlc.TodoappComment, string, error) {
+	row, err := s.queries.ReadComment(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return row, "", newPublicError(connect.NewError(connect.CodeNotFound, ErrCommentDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return row, "", newInternalError(err)
+	}
+
+	ownerID, err := todoOwner(ctx, span, s.queries, userID, row.TodoID, false)
+	if err != nil {
+		return row, "", err
+	}
+
+	if ownerID != row.OwnerID {
+		return row, "", newPublicError(connect.NewError(connect.CodeNotFound, ErrCommentDoesNotExist))
+	}
+
+	return row, ownerID, nil
+}
+
+func newComment(row sqlc.TodoappComment) *pb.Comment {
+	return &pb.Comment{
+		CommentId: row.CommentID,
+		TodoId:    row.TodoID,
+		UserId:    row.UserID,
+		Body:      row.Body,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt: timestamppb.New(row.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/postgres"
+)
+
+var ErrEmptyTodo = errors.New("todo must not be empty")
+
+// updatePaths are the update_mask paths that are applied when the mask is
+// empty. The others are left out so that clients that predate them do not,
+// for example, detach subtasks or cancel reminders with every update.
+var updatePaths = []string{"todo", "due_at"}
+
+// newUpdateTodoParams sets the fields of the params named by the request's
+// update_mask. An empty mask means updatePaths.
+func newUpdateTodoParams(msg *pb.UpdateRequest) (postgres.UpdateTodoParams, error) {
+	paths := msg.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = updatePaths
+	}
+
+	var params postgres.UpdateTodoParams
+	for _, path := range paths {
+		switch path {
+		case "todo":
+			if msg.GetTodo() == "" {
+				return params, ErrEmptyTodo
+			}
+
+			todo := msg.GetTodo()
+			params.Todo = &todo
+		case "due_at":
+			dueAt := newTimestamptz(msg.GetDueAt())
+			params.DueAt = &dueAt
+		case "parent_todo_id":
+			parentTodoID := newText(msg.GetParentTodoId())
+			params.ParentTodoID = &parentTodoID
+		case "rrule":
+			rrule, err := newRRule(msg.GetRrule())
+			if err != nil {
+				return params, err
+			}
+
+			params.RRule = &rrule
+		case "remind_at":
+			remindAt := newTimestamptz(msg.GetRemindAt())
+			params.RemindAt = &remindAt
+		case "priority":
+			priority := int16(msg.GetPriority())
+			params.Priority = &priority
+		default:
+			return params, fmt.Errorf("unknown update_mask path %q", path)
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+
+	// replayedHeader is set on responses that were replayed rather than
+	// created by the request.
+	replayedHeader = "Idempotent-Replayed"
+
+	idempotencyKeyTTL = 24 * time.Hour
+
+	// maxIdempotencyKeyLen limits the Idempotency-Key header as the max_len
+	// of request_id limits the field.
+	maxIdempotencyKeyLen = 100
+)
+
+var (
+	ErrConflictingIdempotencyKeys = errors.New("the Idempotency-Key header and request_id must match")
+	ErrIdempotencyKeyTooLong      = errors.New("the Idempotency-Key header must be at most 100 bytes")
+	ErrIdempotencyKeyReused       = errors.New("the idempotency key was already used for a different request")
+
+	// errIdempotencyKeyTaken means that a concurrent request with the same
+	// key won the race to create the todo.
+	errIdempotencyKeyTaken = errors.New("idempotency key taken")
+)
+
+// idempotencyKey returns the key from either the header or request_id, or ""
+// if the request has none.
+func idempotencyKey(req *connect.Request[pb.CreateRequest]) (string, error) {
+	header := req.Header().Get(idempotencyKeyHeader)
+	field := req.Msg.GetRequestId()
+
+	if len(header) > maxIdempotencyKeyLen {
+		return "", ErrIdempotencyKeyTooLong
+	}
+
+	if header != "" && field != "" && header != field {
+		return "", ErrConflictingIdempotencyKeys
+	}
+
+	if header != "" {
+		return header, nil
+	}
+
+	return field, nil
+}
+
+// requestHash returns a hash of the request, ignoring request_id, since the
+// key may come from either it or the header.
+func requestHash(msg *pb.CreateRequest) ([]byte, error) {
+	msg = proto.Clone(msg).(*pb.CreateRequest)
+	msg.RequestId = ""
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(b)
+	return hash[:], nil
+}
+
+// createIdempotently creates a todo and records the response under key, so
+// that a retry with the same key gets the same response. It reports whether
+// the response was replayed. Reusing the key for a different request is an
+// error.
+func (s *server) createIdempotently(ctx context.Context, span trace.Span, userID, key string, msg *pb.CreateRequest) (*pb.CreateResponse, bool, error) {
+	hash, err := requestHash(msg)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, false, newInternalError(err)
+	}
+
+	var res *pb.CreateResponse
+	var replayed bool
+
+	err = s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		var err error
+		res, err = readIdempotentResponse(ctx, span, q, userID, key, hash)
+		if err != nil || res != nil {
+			replayed = res != nil
+			return err
+		}
+
+		res, err = s.create(ctx, span, q, userID, msg)
+		if err != nil {
+			return err
+		}
+
+		b, err := proto.Marshal(res)
+		if err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		if _, err := q.CreateIdempotencyKey(ctx, sqlc.CreateIdempotencyKeyParams{
+			UserID:      userID,
+			Key:         key,
+			Response:    b,
+			ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(idempotencyKeyTTL), Valid: true},
+			RequestHash: hash,
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errIdempotencyKeyTaken
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		return nil
+	})
+	if errors.Is(err, errIdempotencyKeyTaken) {
+		// Our todo was rolled back; return the winner's instead.
+		res, err = readIdempotentResponse(ctx, span, s.queries, userID, key, hash)
+		return res, true, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res, replayed, nil
+}
+
+// readIdempotentResponse returns the response recorded under key, or nil if
+// there is none. It returns ErrIdempotencyKeyReused if the response was for a
+// request with a different hash.
+func readIdempotentResponse(ctx context.Context, span trace.Span, q *sqlc.Queries, userID, key string, hash []byte) (*pb.CreateResponse, error) {
+	row, err := q.ReadIdempotencyKey(ctx, sqlc.ReadIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	if row.RequestHash != nil && !bytes.Equal(row.RequestHash, hash) {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrIdempotencyKeyReused))
+	}
+
+	var res pb.CreateResponse
+	if err := proto.Unmarshal(row.Response, &res); err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return &res, nil
+}
+
+// RunIdempotencyKeyPurger deletes expired idempotency keys every interval
+// until ctx is done.
+func RunIdempotencyKeyPurger(ctx context.Context, pool *pgxpool.Pool, interval time.Duration) {
+	queries := sqlc.New(pool)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := queries.DeleteExpiredIdempotencyKeys(ctx)
+			if err != nil {
+				slog.ErrorCtx(ctx, "error purging idempotency keys", "error", err.Error())
+				continue
+			}
+
+			if n > 0 {
+				slog.InfoCtx(ctx, "purged idempotency keys", "count", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/gen/todoapp/v1/todoappv1connect"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type listServer struct {
+	todoappv1connect.UnimplementedListServiceHandler
+
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewListServer(pool *pgxpool.Pool) *listServer {
+	return &listServer{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (s *listServer) CreateList(ctx context.Context, req *connect.Request[pb.CreateListRequest]) (*connect.Response[pb.CreateListResponse], error) {
+	ctx, span := tracer.Start(ctx, "CreateList")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	row, err := s.queries.CreateList(ctx, sqlc.CreateListParams{
+		UserID: userID,
+		Name:   req.Msg.GetName(),
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.CreateListResponse{
+		List: newList(row),
+	}), nil
+}
+
+func (s *listServer) ReadList(ctx context.Context, req *connect.Request[pb.ReadListRequest]) (*connect.Response[pb.ReadListResponse], error) {
+	ctx, span := tracer.Start(ctx, "ReadList")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	listID := req.Msg.GetListId()
+
+	// Collaborators can read the lists shared with them.
+	role, err := s.queries.ReadListRole(ctx, sqlc.ReadListRoleParams{
+		UserID: userID,
+		ListID: listID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	row, err := s.queries.ReadList(ctx, sqlc.ReadListParams{
+		UserID: role.OwnerID,
+		ListID: listID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.ReadListResponse{
+		List: newList(row),
+	}), nil
+}
+
+func (s *listServer) ReadAllLists(ctx context.Context, req *connect.Request[pb.ReadAllListsRequest]) (*connect.Response[pb.ReadAllListsResponse], error) {
+	ctx, span := tracer.Start(ctx, "ReadAllLists")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	rows, err := s.queries.ReadAllLists(ctx, userID)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	lists := make([]*pb.List, 0, len(rows))
+	for _, row := range rows {
+		lists = append(lists, newList(row))
+	}
+
+	return connect.NewResponse(&pb.ReadAllListsResponse{
+		Lists: lists,
+	}), nil
+}
+
+func (s *listServer) UpdateList(ctx context.Context, req *connect.Request[pb.UpdateListRequest]) (*connect.Response[pb.UpdateListResponse], error) {
+	ctx, span := tracer.Start(ctx, "UpdateList")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	row, err := s.queries.UpdateList(ctx, sqlc.UpdateListParams{
+		UserID: userID,
+		ListID: msg.GetListId(),
+		Name:   msg.GetName(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.UpdateListResponse{
+		List: newList(row),
+	}), nil
+}
+
+// DeleteList moves the todos in the list to the trash, as if each had been
+// deleted, and then deletes the list. Todos that were already in the trash
+// are taken out of the list so that they can still be restored.
+func (s *listServer) DeleteList(ctx context.Context, req *connect.Request[pb.DeleteListRequest]) (*connect.Response[pb.DeleteListResponse], error) {
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	listID := req.Msg.GetListId()
+
+	ctx, span := tracer.Start(ctx, "DeleteList", trace.WithAttributes(attribute.String("userID", userID), attribute.String("listID", listID)))
+	defer span.End()
+
+	err := runInTx(ctx, span, s.pool, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		// Locking the list waits for todos being created in it, and makes
+		// later creates wait until it is gone.
+		if _, err := q.ReadListForUpdate(ctx, sqlc.ReadListForUpdateParams{
+			UserID: userID,
+			ListID: listID,
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		todoIDs, err := q.TrashListTodos(ctx, sqlc.TrashListTodosParams{
+			UserID: userID,
+			ListID: listID,
+		})
+		if err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		for _, todoID := range todoIDs {
+			if err := logOperation(ctx, span, q, userID, todoID, operationDelete); err != nil {
+				return err
+			}
+		}
+
+		if err := q.DetachListTodos(ctx, sqlc.DetachListTodosParams{
+			UserID: userID,
+			ListID: listID,
+		}); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		if err := q.DeleteList(ctx, sqlc.DeleteListParams{
+			UserID: userID,
+			ListID: listID,
+		}); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.DeleteListResponse{}), nil
+}
+
+func newList(row sqlc.TodoappList) *pb.List {
+	return &pb.List{
+		UserId:    row.UserID,
+		ListId:    row.ListID,
+		Name:      row.Name,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt: timestamppb.New(row.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const defaultPageSize = 100
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// encodePageToken returns an opaque token that points just past the row with
+// the given id.
+func encodePageToken(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// decodePageToken is the inverse of encodePageToken. The empty token is the
+// start of the collection.
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+
+	id, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || id < 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return id, nil
+}
+
+func pageSize(requested int32) int32 {
+	if requested <= 0 {
+		return defaultPageSize
+	}
+
+	return requested
+}
+
+// listCursor is the position of the last todo on a page of List results.
+// Todos are ordered by (key, priority, position, id), so the cursor is stable
+// even when many todos share a key. priority and position are only set when
+// sorting by them. Positions can grow long, so position is not part of the
+// token and is looked up by id instead.
+type listCursor struct {
+	sortBy     string
+	descending bool
+	key        pgtype.Timestamptz
+	priority   int16
+	position   string
+	id         int64
+}
+
+// encodeListPageToken returns an opaque token for the given cursor. The sort
+// order is part of the token so that it cannot be used with a different one.
+func encodeListPageToken(c listCursor) string {
+	var key string
+	switch c.key.InfinityModifier {
+	case pgtype.Infinity:
+		key = "inf"
+	case pgtype.NegativeInfinity:
+		key = "-inf"
+	default:
+		key = strconv.FormatInt(c.key.Time.UnixMicro(), 10)
+	}
+
+	s := fmt.Sprintf("%s:%t:%s:%d:%d", c.sortBy, c.descending, key, c.priority, c.id)
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// decodeListPageToken is the inverse of encodeListPageToken. It returns
+// ErrInvalidPageToken if the token is malformed or was issued for a different
+// sort order.
+func decodeListPageToken(token, sortBy string, descending bool) (listCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return listCursor{}, ErrInvalidPageToken
+	}
+
+	parts := strings.Split(string(b), ":")
+	if len(parts) != 5 || parts[0] != sortBy || parts[1] != strconv.FormatBool(descending) {
+		return listCursor{}, ErrInvalidPageToken
+	}
+
+	var key pgtype.Timestamptz
+	switch parts[2] {
+	case "inf":
+		key = pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}
+	case "-inf":
+		key = pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}
+	default:
+		micros, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return listCursor{}, ErrInvalidPageToken
+		}
+
+		key = pgtype.Timestamptz{Time: time.UnixMicro(micros), Valid: true}
+	}
+
+	priority, err := strconv.ParseInt(parts[3], 10, 16)
+	if err != nil {
+		return listCursor{}, ErrInvalidPageToken
+	}
+
+	id, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil || id < 0 {
+		return listCursor{}, ErrInvalidPageToken
+	}
+
+	return listCursor{
+		sortBy:     sortBy,
+		descending: descending,
+		key:        key,
+		priority:   int16(priority),
+		id:         id,
+	}, nil
+}
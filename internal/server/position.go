@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// positionDigits are the digits of a position, in ascending byte order.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// maxPositionLen is the length past which Move renumbers the list. The
+// todo_set_position trigger uses the same limit when appending.
+const maxPositionLen = 50
+
+var (
+	ErrMoveRelativeToItself    = errors.New("a todo cannot be moved relative to itself")
+	ErrMoveTargetInAnotherList = errors.New("todos can only be moved relative to todos in the same list")
+)
+
+// Move changes a todo's position so that it comes right before or after
+// another todo in the same list. Only the moved todo changes, although the
+// positions of the whole list are renumbered when they get too long.
+func (s *server) Move(ctx context.Context, req *connect.Request[pb.MoveRequest]) (*connect.Response[pb.MoveResponse], error) {
+	ctx, span := tracer.Start(ctx, "Move")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	targetID, after := msg.GetBeforeTodoId(), false
+	if msg.GetAfterTodoId() != "" {
+		targetID, after = msg.GetAfterTodoId(), true
+	}
+
+	if targetID == msg.GetTodoId() {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrMoveRelativeToItself))
+	}
+
+	var row sqlc.TodoappTodo
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		// Otherwise a concurrent move could take the position we pick.
+		if err := q.LockUserTodos(ctx, userID); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		var todos [2]sqlc.TodoappTodo
+		for i, todoID := range []string{msg.GetTodoId(), targetID} {
+			todo, err := q.Read(ctx, sqlc.ReadParams{
+				UserID: userID,
+				TodoID: todoID,
+			})
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+				}
+
+				instrumentation.TraceError(span, err)
+				return newInternalError(err)
+			}
+
+			todos[i] = todo
+		}
+
+		todo, target := todos[0], todos[1]
+		if todo.ListID != target.ListID {
+			return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrMoveTargetInAnotherList))
+		}
+
+		position, err := positionNextTo(ctx, span, q, todo, target, after)
+		if err != nil {
+			return err
+		}
+
+		// Repeated moves into the same gap make positions longer, so the
+		// list is renumbered once they get too long.
+		if len(position) > maxPositionLen {
+			if err := q.RenumberPositions(ctx, sqlc.RenumberPositionsParams{
+				UserID: userID,
+				ListID: target.ListID,
+			}); err != nil {
+				instrumentation.TraceError(span, err)
+				return newInternalError(err)
+			}
+
+			target, err = q.Read(ctx, sqlc.ReadParams{
+				UserID: userID,
+				TodoID: target.TodoID,
+			})
+			if err != nil {
+				instrumentation.TraceError(span, err)
+				return newInternalError(err)
+			}
+
+			position, err = positionNextTo(ctx, span, q, todo, target, after)
+			if err != nil {
+				return err
+			}
+		}
+
+		row, err = q.SetPosition(ctx, sqlc.SetPositionParams{
+			UserID:   userID,
+			TodoID:   todo.TodoID,
+			Position: position,
+		})
+		if err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.MoveResponse{
+		Todo: newReadResponse(row),
+	}), nil
+}
+
+// positionNextTo returns a position for todo right before or after target,
+// which must be in the same list.
+func positionNextTo(ctx context.Context, span trace.Span, q *sqlc.Queries, todo, target sqlc.TodoappTodo, after bool) (string, error) {
+	// The neighbour on the other side of the target, ignoring the todo being
+	// moved.
+	neighbour := sqlc.ReadPositionAfterParams{
+		UserID:   target.UserID,
+		ListID:   target.ListID,
+		TodoID:   todo.TodoID,
+		Position: target.Position,
+		ID:       target.ID,
+	}
+
+	var lower, upper string
+	var err error
+	if after {
+		lower = target.Position
+		upper, err = q.ReadPositionAfter(ctx, neighbour)
+	} else {
+		upper = target.Position
+		lower, err = q.ReadPositionBefore(ctx, sqlc.ReadPositionBeforeParams(neighbour))
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		instrumentation.TraceError(span, err)
+		return "", newInternalError(err)
+	}
+
+	if upper != "" && lower >= upper {
+		err := fmt.Errorf("no position between %q and %q", lower, upper)
+		instrumentation.TraceError(span, err)
+		return "", newInternalError(err)
+	}
+
+	return positionBetween(lower, upper), nil
+}
+
+// positionBetween returns a position that sorts strictly between a and b,
+// where an empty a or b means unbounded. a must sort before b. Positions
+// never end in the zero digit, which guarantees that there is always room
+// between two of them.
+func positionBetween(a, b string) string {
+	if b != "" {
+		// Keep any common prefix, treating a as padded with zeros.
+		n := 0
+		for n < len(b) && positionDigitAt(a, n) == b[n] {
+			n++
+		}
+
+		if n > 0 {
+			return b[:n] + positionBetween(positionTail(a, n), b[n:])
+		}
+	}
+
+	da := strings.IndexByte(positionDigits, positionDigitAt(a, 0))
+	db := len(positionDigits)
+	if b != "" {
+		db = strings.IndexByte(positionDigits, b[0])
+	}
+
+	if db-da > 1 {
+		return string(positionDigits[(da+db)/2])
+	}
+
+	// The first digits are adjacent. If b has more digits, its first digit
+	// alone sorts between the two.
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	return string(positionDigits[da]) + positionBetween(positionTail(a, 1), "")
+}
+
+func positionDigitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+
+	return positionDigits[0]
+}
+
+func positionTail(s string, i int) string {
+	if i < len(s) {
+		return s[i:]
+	}
+
+	return ""
+}
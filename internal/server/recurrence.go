@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/craigpastro/todoapp/internal/rrule"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newRRule validates an optional rule, where the empty string is NULL, and
+// returns it in canonical form.
+func newRRule(s string) (pgtype.Text, error) {
+	if s == "" {
+		return pgtype.Text{}, nil
+	}
+
+	rule, err := rrule.Parse(s)
+	if err != nil {
+		return pgtype.Text{}, err
+	}
+
+	return pgtype.Text{String: rule.String(), Valid: true}, nil
+}
+
+// createNextOccurrence creates the next occurrence of todo, a repeating todo
+// that is being completed, with the same text, list, parent, priority and
+// tags, and a reminder as long before it is due as todo's was. It returns nil
+// if the rule has no more occurrences.
+//
+// The next occurrence is due at the rule's first time after todo's due date,
+// or after now if it has none, so a todo without a due date repeats from when
+// it was completed.
+func (s *server) createNextOccurrence(ctx context.Context, span trace.Span, q *sqlc.Queries, todo sqlc.TodoappTodo) (*sqlc.TodoappTodo, error) {
+	rule, err := rrule.Parse(todo.Rrule.String)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	dtstart := time.Now()
+	if todo.DueAt.Valid {
+		dtstart = todo.DueAt.Time
+	}
+
+	dueAt, ok := rule.Next(dtstart)
+	if !ok {
+		return nil, nil
+	}
+
+	var remindAt pgtype.Timestamptz
+	if todo.RemindAt.Valid && todo.DueAt.Valid {
+		remindAt = pgtype.Timestamptz{Time: dueAt.Add(todo.RemindAt.Time.Sub(todo.DueAt.Time)), Valid: true}
+	}
+
+	// COUNT counts this occurrence, which is done.
+	if rule.Count > 0 {
+		rule.Count--
+	}
+
+	row, err := q.Create(ctx, sqlc.CreateParams{
+		UserID:       todo.UserID,
+		Todo:         todo.Todo,
+		DueAt:        pgtype.Timestamptz{Time: dueAt, Valid: true},
+		ListID:       todo.ListID,
+		ParentTodoID: todo.ParentTodoID,
+		Rrule:        pgtype.Text{String: rule.String(), Valid: true},
+		RemindAt:     remindAt,
+		Priority:     todo.Priority,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	err = q.CopyTags(ctx, sqlc.CopyTagsParams{
+		ToTodoID:   row.TodoID,
+		UserID:     todo.UserID,
+		FromTodoID: todo.TodoID,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return &row, nil
+}
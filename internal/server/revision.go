@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/craigpastro/todoapp/internal/postgres"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrRevisionDoesNotExist = errors.New("revision does not exist")
+
+func (s *server) ListRevisions(ctx context.Context, req *connect.Request[pb.ListRevisionsRequest]) (*connect.Response[pb.ListRevisionsResponse], error) {
+	ctx, span := tracer.Start(ctx, "ListRevisions")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+	todoID := msg.GetTodoId()
+
+	afterID, err := decodePageToken(msg.GetPageToken())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	ownerID, err := todoOwner(ctx, span, s.queries, userID, todoID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queries.Read(ctx, sqlc.ReadParams{
+		UserID: ownerID,
+		TodoID: todoID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	size := pageSize(msg.GetPageSize())
+
+	// Fetch one extra row so we know whether there is another page.
+	rows, err := s.queries.ReadRevisionPage(ctx, sqlc.ReadRevisionPageParams{
+		UserID: ownerID,
+		TodoID: todoID,
+		ID:     afterID,
+		Limit:  size + 1,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	var nextPageToken string
+	if len(rows) > int(size) {
+		rows = rows[:size]
+		nextPageToken = encodePageToken(rows[len(rows)-1].ID)
+	}
+
+	revisions := make([]*pb.Revision, 0, len(rows))
+	for _, row := range rows {
+		revisions = append(revisions, newRevision(row))
+	}
+
+	return connect.NewResponse(&pb.ListRevisionsResponse{
+		Revisions:     revisions,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// RestoreRevision sets the todo's text and due date back to those of an
+// earlier version. The restore is itself a change, so it gets a new version
+// and revision, and can be undone like an update.
+func (s *server) RestoreRevision(ctx context.Context, req *connect.Request[pb.RestoreRevisionRequest]) (*connect.Response[pb.RestoreRevisionResponse], error) {
+	ctx, span := tracer.Start(ctx, "RestoreRevision")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+	todoID := msg.GetTodoId()
+
+	var row sqlc.TodoappTodo
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		ownerID, err := todoOwner(ctx, span, q, userID, todoID, true)
+		if err != nil {
+			return err
+		}
+
+		// Locking the todo keeps it from changing between reading the
+		// revision and restoring it. Undo needs it as it was before, too.
+		before, err := q.ReadForUpdate(ctx, sqlc.ReadForUpdateParams{
+			UserID: ownerID,
+			TodoID: todoID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		revision, err := q.ReadRevision(ctx, sqlc.ReadRevisionParams{
+			UserID:  ownerID,
+			TodoID:  todoID,
+			Version: msg.GetVersion(),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return newPublicError(connect.NewError(connect.CodeNotFound, ErrRevisionDoesNotExist))
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		row, err = postgres.UpdateTodo(ctx, tx, postgres.UpdateTodoParams{
+			UserID:          ownerID,
+			TodoID:          todoID,
+			ExpectedVersion: msg.GetExpectedVersion(),
+			Todo:            &revision.Todo,
+			DueAt:           &revision.DueAt,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return noRowsError(ctx, span, q, ownerID, todoID, msg.GetExpectedVersion())
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		// The operation log is per user, so Undo only covers changes to the
+		// user's own todos.
+		if ownerID == userID {
+			return logUpdate(ctx, span, q, before)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.RestoreRevisionResponse{
+		Todo: newReadResponse(row),
+	}), nil
+}
+
+func newRevision(row sqlc.TodoappTodoRevision) *pb.Revision {
+	return &pb.Revision{
+		TodoId:    row.TodoID,
+		Version:   row.Version,
+		Todo:      row.Todo,
+		DueAt:     newTimestamp(row.DueAt),
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+}
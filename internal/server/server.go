@@ -10,7 +10,11 @@ import (
 	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
 	"github.com/craigpastro/todoapp/internal/gen/todoapp/v1/todoappv1connect"
 	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/craigpastro/todoapp/internal/postgres"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -21,17 +25,42 @@ var (
 	tracer = otel.Tracer("internal/server")
 
 	ErrTodoIDDoesNotExist = errors.New("todo id does not exist")
+	ErrListIDDoesNotExist = errors.New("list id does not exist")
+	ErrVersionMismatch    = errors.New("todo has been changed since it was read")
+
+	ErrParentTodoIDDoesNotExist = errors.New("parent todo id does not exist")
+	ErrParentCycle              = errors.New("a todo cannot be a subtask of itself or of its own subtasks")
+)
+
+const (
+	// Postgres SQLSTATEs.
+	foreignKeyViolation = "23503"
+	checkViolation      = "23514"
+
+	listForeignKey        = "todo_list_fkey"
+	todoTagForeignKey     = "todo_tag_user_id_todo_id_fkey"
+	parentForeignKey      = "todo_parent_fkey"
+	parentCycleConstraint = "todo_parent_cycle"
+
+	listShareForeignKey         = "list_share_list_fkey"
+	listShareNotOwnerConstraint = "list_share_not_owner"
+
+	commentTodoForeignKey = "comment_todo_fkey"
 )
 
 type server struct {
 	todoappv1connect.UnimplementedTodoAppServiceHandler
 
+	pool    *pgxpool.Pool
 	queries *sqlc.Queries
+	broker  *broker
 }
 
-func NewServer(queries *sqlc.Queries) *server {
+func NewServer(pool *pgxpool.Pool) *server {
 	return &server{
-		queries: queries,
+		pool:    pool,
+		queries: sqlc.New(pool),
+		broker:  newBroker(),
 	}
 }
 
@@ -41,22 +70,94 @@ func (s *server) Create(ctx context.Context, req *connect.Request[pb.CreateReque
 
 	userID := ctxpkg.GetUserIDFromCtx(ctx)
 
-	row, err := s.queries.Create(ctx, sqlc.CreateParams{
-		UserID: userID,
-		Todo:   req.Msg.GetTodo(),
+	key, err := idempotencyKey(req)
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	if key == "" {
+		var res *pb.CreateResponse
+		err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+			var err error
+			res, err = s.create(ctx, span, s.queries.WithTx(tx), userID, req.Msg)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(res), nil
+	}
+
+	res, replayed, err := s.createIdempotently(ctx, span, userID, key, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := connect.NewResponse(res)
+	if replayed {
+		resp.Header().Set(replayedHeader, "true")
+	}
+
+	return resp, nil
+}
+
+// create is Create using the given queries, which must be in a transaction so
+// that the todo and its operation log entry are made together.
+func (s *server) create(ctx context.Context, span trace.Span, q *sqlc.Queries, userID string, msg *pb.CreateRequest) (*pb.CreateResponse, error) {
+	rrule, err := newRRule(msg.GetRrule())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	row, err := q.Create(ctx, sqlc.CreateParams{
+		UserID:       userID,
+		Todo:         msg.GetTodo(),
+		DueAt:        newTimestamptz(msg.GetDueAt()),
+		ListID:       newText(msg.GetListId()),
+		ParentTodoID: newText(msg.GetParentTodoId()),
+		Rrule:        rrule,
+		RemindAt:     newTimestamptz(msg.GetRemindAt()),
+		Priority:     int16(msg.GetPriority()),
 	})
 	if err != nil {
+		if isForeignKeyViolation(err, listForeignKey) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		if isForeignKeyViolation(err, parentForeignKey) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrParentTodoIDDoesNotExist))
+		}
+
 		instrumentation.TraceError(span, err)
 		return nil, newInternalError(err)
 	}
 
-	return connect.NewResponse(&pb.CreateResponse{
-		UserId:    row.UserID,
-		TodoId:    row.TodoID,
-		Todo:      row.Todo,
-		CreatedAt: timestamppb.New(row.CreatedAt.Time),
-		UpdatedAt: timestamppb.New(row.UpdatedAt.Time),
-	}), nil
+	if err := logOperation(ctx, span, q, userID, row.TodoID, operationCreate); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateResponse{
+		UserId:              row.UserID,
+		TodoId:              row.TodoID,
+		Todo:                row.Todo,
+		CreatedAt:           timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt:           timestamppb.New(row.UpdatedAt.Time),
+		Completed:           row.Completed,
+		CompletedAt:         newTimestamp(row.CompletedAt),
+		DueAt:               newTimestamp(row.DueAt),
+		ListId:              row.ListID.String,
+		Version:             row.Version,
+		DeletedAt:           newTimestamp(row.DeletedAt),
+		ParentTodoId:        row.ParentTodoID.String,
+		ChildCount:          row.ChildCount,
+		CompletedChildCount: row.CompletedChildCount,
+		Position:            row.Position,
+		Rrule:               row.Rrule.String,
+		RemindAt:            newTimestamp(row.RemindAt),
+		Priority:            pb.Priority(row.Priority),
+		AssigneeId:          row.AssigneeID.String,
+	}, nil
 }
 
 func (s *server) Read(ctx context.Context, req *connect.Request[pb.ReadRequest]) (*connect.Response[pb.ReadResponse], error) {
@@ -66,8 +167,13 @@ func (s *server) Read(ctx context.Context, req *connect.Request[pb.ReadRequest])
 	userID := ctxpkg.GetUserIDFromCtx(ctx)
 	todoID := req.Msg.GetTodoId()
 
+	ownerID, err := todoOwner(ctx, span, s.queries, userID, todoID, false)
+	if err != nil {
+		return nil, err
+	}
+
 	row, err := s.queries.Read(ctx, sqlc.ReadParams{
-		UserID: userID,
+		UserID: ownerID,
 		TodoID: todoID,
 	})
 	if err != nil {
@@ -79,13 +185,7 @@ func (s *server) Read(ctx context.Context, req *connect.Request[pb.ReadRequest])
 		return nil, newInternalError(err)
 	}
 
-	return connect.NewResponse(&pb.ReadResponse{
-		UserId:    row.UserID,
-		TodoId:    row.TodoID,
-		Todo:      row.Todo,
-		CreatedAt: timestamppb.New(row.CreatedAt.Time),
-		UpdatedAt: timestamppb.New(row.UpdatedAt.Time),
-	}), nil
+	return connect.NewResponse(newReadResponse(row)), nil
 }
 
 func (s *server) ReadAll(ctx context.Context, req *connect.Request[pb.ReadAllRequest]) (*connect.Response[pb.ReadAllResponse], error) {
@@ -93,47 +193,273 @@ func (s *server) ReadAll(ctx context.Context, req *connect.Request[pb.ReadAllReq
 	defer span.End()
 
 	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	afterID, err := decodePageToken(msg.GetPageToken())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	size := pageSize(msg.GetPageSize())
 
+	// Fetch one extra row so we know whether there is another page.
 	rows, err := s.queries.ReadPage(ctx, sqlc.ReadPageParams{
-		UserID: userID,
+		UserID:    userID,
+		ID:        afterID,
+		DueBefore: newTimestamptz(msg.GetDueBefore()),
+		DueAfter:  newTimestamptz(msg.GetDueAfter()),
+		Overdue:   msg.GetOverdue(),
+		NoDueDate: msg.GetNoDueDate(),
+		Tag:       newTagFilter(msg.GetTag()),
+		ListID:    newText(msg.GetListId()),
+		Limit:     size + 1,
 	})
 	if err != nil {
 		instrumentation.TraceError(span, err)
 		return nil, newInternalError(err)
 	}
 
+	var nextPageToken string
+	if len(rows) > int(size) {
+		rows = rows[:size]
+		nextPageToken = encodePageToken(rows[len(rows)-1].ID)
+	}
+
 	var lastIndex int64
 	todos := make([]*pb.ReadResponse, 0, len(rows))
 	for _, row := range rows {
 		lastIndex = row.ID
 
-		todos = append(todos, &pb.ReadResponse{
-			UserId:    row.UserID,
-			TodoId:    row.TodoID,
-			Todo:      row.Todo,
-			CreatedAt: timestamppb.New(row.CreatedAt.Time),
-			UpdatedAt: timestamppb.New(row.UpdatedAt.Time),
-		})
+		todos = append(todos, newReadResponse(row))
 	}
 
 	return connect.NewResponse(&pb.ReadAllResponse{
-		Todos:     todos,
-		LastIndex: lastIndex,
+		Todos:         todos,
+		LastIndex:     lastIndex,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *server) List(ctx context.Context, req *connect.Request[pb.ListRequest]) (*connect.Response[pb.ListResponse], error) {
+	ctx, span := tracer.Start(ctx, "List")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+	sortBy := sortColumn(msg.GetOrderBy())
+
+	params := sqlc.ListParams{
+		UserID:        userID,
+		TextContains:  msg.GetTextContains(),
+		CreatedAfter:  newTimestamptz(msg.GetCreatedAfter()),
+		CreatedBefore: newTimestamptz(msg.GetCreatedBefore()),
+		UpdatedAfter:  newTimestamptz(msg.GetUpdatedAfter()),
+		UpdatedBefore: newTimestamptz(msg.GetUpdatedBefore()),
+		ListID:        newText(msg.GetListId()),
+		Priorities:    []int16{}, // nil would be NULL, not empty
+		Descending:    msg.GetDescending(),
+		SortBy:        sortBy,
+	}
+
+	for _, priority := range msg.GetPriorities() {
+		params.Priorities = append(params.Priorities, int16(priority))
+	}
+
+	if msg.Completed != nil {
+		params.Completed = pgtype.Bool{Bool: msg.GetCompleted(), Valid: true}
+	}
+
+	// A list shared with the user lists its owner's todos.
+	if msg.GetListId() != "" {
+		role, err := s.queries.ReadListRole(ctx, sqlc.ReadListRoleParams{
+			UserID: userID,
+			ListID: msg.GetListId(),
+		})
+		if err == nil {
+			params.UserID = role.OwnerID
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			instrumentation.TraceError(span, err)
+			return nil, newInternalError(err)
+		}
+	}
+
+	if msg.GetPageToken() != "" {
+		cursor, err := decodeListPageToken(msg.GetPageToken(), sortBy, msg.GetDescending())
+		if err != nil {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+
+		if sortBy == "position" {
+			cursor.position, err = s.queries.ReadPositionByID(ctx, sqlc.ReadPositionByIDParams{
+				UserID: params.UserID,
+				ID:     cursor.id,
+			})
+			if err != nil {
+				// The todo was purged since the token was issued.
+				if errors.Is(err, pgx.ErrNoRows) {
+					return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrInvalidPageToken))
+				}
+
+				instrumentation.TraceError(span, err)
+				return nil, newInternalError(err)
+			}
+		}
+
+		params.HasCursor = true
+		params.CursorKey = cursor.key
+		params.CursorPriority = cursor.priority
+		params.CursorPosition = cursor.position
+		params.CursorID = cursor.id
+	}
+
+	size := pageSize(msg.GetPageSize())
+
+	// Fetch one extra row so we know whether there is another page.
+	params.Limit = size + 1
+
+	rows, err := s.queries.List(ctx, params)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	var nextPageToken string
+	if len(rows) > int(size) {
+		rows = rows[:size]
+		last := rows[len(rows)-1]
+		cursor := listCursor{
+			sortBy:     sortBy,
+			descending: msg.GetDescending(),
+			key:        sortKey(last, sortBy),
+			id:         last.ID,
+		}
+		if sortBy == "priority" {
+			cursor.priority = last.Priority
+		}
+
+		nextPageToken = encodeListPageToken(cursor)
+	}
+
+	todos := make([]*pb.ReadResponse, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, newReadResponse(row))
+	}
+
+	return connect.NewResponse(&pb.ListResponse{
+		Todos:         todos,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *server) Search(ctx context.Context, req *connect.Request[pb.SearchRequest]) (*connect.Response[pb.SearchResponse], error) {
+	ctx, span := tracer.Start(ctx, "Search")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	rows, err := s.queries.Search(ctx, sqlc.SearchParams{
+		UserID: userID,
+		Query:  msg.GetQuery(),
+		Limit:  pageSize(msg.GetPageSize()),
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	results := make([]*pb.SearchResult, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, &pb.SearchResult{
+			Todo:    newReadResponse(row.TodoappTodo),
+			Rank:    row.Rank,
+			Snippet: row.Snippet,
+		})
+	}
+
+	return connect.NewResponse(&pb.SearchResponse{
+		Results: results,
 	}), nil
 }
 
+// sortColumn returns the name the List query uses for the sort field.
+func sortColumn(field pb.SortField) string {
+	switch field {
+	case pb.SortField_SORT_FIELD_UPDATED_AT:
+		return "updated_at"
+	case pb.SortField_SORT_FIELD_DUE_AT:
+		return "due_at"
+	case pb.SortField_SORT_FIELD_POSITION:
+		return "position"
+	case pb.SortField_SORT_FIELD_PRIORITY:
+		return "priority"
+	default:
+		return "created_at"
+	}
+}
+
+// sortKey returns the timestamp the List query sorts row by. It must agree
+// with the query, which sorts todos without a due date as if due at infinity,
+// and uses -infinity for every todo when sorting by position or priority.
+func sortKey(row sqlc.TodoappTodo, sortBy string) pgtype.Timestamptz {
+	switch sortBy {
+	case "updated_at":
+		return row.UpdatedAt
+	case "due_at":
+		if !row.DueAt.Valid {
+			return pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}
+		}
+		return row.DueAt
+	case "position", "priority":
+		return pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}
+	default:
+		return row.CreatedAt
+	}
+}
+
 func (s *server) Update(ctx context.Context, req *connect.Request[pb.UpdateRequest]) (*connect.Response[pb.UpdateResponse], error) {
 	ctx, span := tracer.Start(ctx, "Update")
 	defer span.End()
 
 	userID := ctxpkg.GetUserIDFromCtx(ctx)
-	msg := req.Msg
+
+	var res *pb.UpdateResponse
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		var err error
+		res, err = s.update(ctx, span, tx, userID, req.Msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// update is Update using the given connection, which must be a transaction
+// so that the update and its operation log entry are made together.
+func (s *server) update(ctx context.Context, span trace.Span, db sqlc.DBTX, userID string, msg *pb.UpdateRequest) (*pb.UpdateResponse, error) {
 	todoID := msg.GetTodoId()
+	q := sqlc.New(db)
 
-	row, err := s.queries.Update(ctx, sqlc.UpdateParams{
-		UserID: userID,
+	params, err := newUpdateTodoParams(msg)
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	ownerID, err := todoOwner(ctx, span, q, userID, todoID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	params.UserID = ownerID
+	params.TodoID = todoID
+	params.ExpectedVersion = msg.GetExpectedVersion()
+
+	// Undo needs the todo as it was before the update.
+	before, err := q.ReadForUpdate(ctx, sqlc.ReadForUpdateParams{
+		UserID: ownerID,
 		TodoID: todoID,
-		Todo:   msg.GetTodo(),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -144,13 +470,53 @@ func (s *server) Update(ctx context.Context, req *connect.Request[pb.UpdateReque
 		return nil, newInternalError(err)
 	}
 
-	return connect.NewResponse(&pb.UpdateResponse{
-		UserId:    row.UserID,
-		TodoId:    row.TodoID,
-		Todo:      row.Todo,
-		CreatedAt: timestamppb.New(row.CreatedAt.Time),
-		UpdatedAt: timestamppb.New(row.UpdatedAt.Time),
-	}), nil
+	row, err := postgres.UpdateTodo(ctx, db, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, noRowsError(ctx, span, q, ownerID, todoID, msg.GetExpectedVersion())
+		}
+
+		if isForeignKeyViolation(err, parentForeignKey) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrParentTodoIDDoesNotExist))
+		}
+
+		if isCheckViolation(err, parentCycleConstraint) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrParentCycle))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	// The operation log is per user, so Undo only covers changes to the
+	// user's own todos.
+	if ownerID == userID {
+		if err := logUpdate(ctx, span, q, before); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.UpdateResponse{
+		UserId:              row.UserID,
+		TodoId:              row.TodoID,
+		Todo:                row.Todo,
+		CreatedAt:           timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt:           timestamppb.New(row.UpdatedAt.Time),
+		Completed:           row.Completed,
+		CompletedAt:         newTimestamp(row.CompletedAt),
+		DueAt:               newTimestamp(row.DueAt),
+		ListId:              row.ListID.String,
+		Version:             row.Version,
+		DeletedAt:           newTimestamp(row.DeletedAt),
+		ParentTodoId:        row.ParentTodoID.String,
+		ChildCount:          row.ChildCount,
+		CompletedChildCount: row.CompletedChildCount,
+		Position:            row.Position,
+		Rrule:               row.Rrule.String,
+		RemindAt:            newTimestamp(row.RemindAt),
+		Priority:            pb.Priority(row.Priority),
+		AssigneeId:          row.AssigneeID.String,
+	}, nil
 }
 
 func (s *server) Delete(ctx context.Context, req *connect.Request[pb.DeleteRequest]) (*connect.Response[pb.DeleteResponse], error) {
@@ -160,15 +526,252 @@ func (s *server) Delete(ctx context.Context, req *connect.Request[pb.DeleteReque
 	ctx, span := tracer.Start(ctx, "Delete", trace.WithAttributes(attribute.String("userID", userID), attribute.String("postID", todoID)))
 	defer span.End()
 
-	if err := s.queries.Delete(ctx, sqlc.DeleteParams{
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		return s.delete(ctx, span, s.queries.WithTx(tx), userID, req.Msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.DeleteResponse{}), nil
+}
+
+// delete is Delete using the given queries, which must be in a transaction so
+// that the delete and its operation log entry are made together.
+func (s *server) delete(ctx context.Context, span trace.Span, q *sqlc.Queries, userID string, msg *pb.DeleteRequest) error {
+	todoID := msg.GetTodoId()
+
+	ownerID, err := todoOwner(ctx, span, q, userID, todoID, true)
+	if err != nil {
+		return err
+	}
+
+	n, err := q.Delete(ctx, sqlc.DeleteParams{
+		UserID:          ownerID,
+		TodoID:          todoID,
+		ExpectedVersion: msg.GetExpectedVersion(),
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return newInternalError(err)
+	}
+
+	if n == 0 {
+		err := noRowsError(ctx, span, q, ownerID, todoID, msg.GetExpectedVersion())
+		if errors.Is(err, ErrTodoIDDoesNotExist) {
+			return newPublicError(connect.NewError(connect.CodeNotFound, ErrTodoIDDoesNotExist))
+		}
+
+		return err
+	}
+
+	if ownerID != userID {
+		return nil
+	}
+
+	return logOperation(ctx, span, q, userID, todoID, operationDelete)
+}
+
+func (s *server) Complete(ctx context.Context, req *connect.Request[pb.CompleteRequest]) (*connect.Response[pb.CompleteResponse], error) {
+	ctx, span := tracer.Start(ctx, "Complete")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	todoID := req.Msg.GetTodoId()
+
+	res := &pb.CompleteResponse{}
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		// Lock the todo so that concurrent Completes of a repeating todo do
+		// not both create its next occurrence. Complete clears the rule, so
+		// only the first sees it.
+		todo, err := q.ReadForUpdate(ctx, sqlc.ReadForUpdateParams{
+			UserID: userID,
+			TodoID: todoID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		row, err := q.Complete(ctx, sqlc.CompleteParams{
+			UserID: userID,
+			TodoID: todoID,
+		})
+		if err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		res.Todo = newReadResponse(row)
+
+		if !todo.Rrule.Valid {
+			return nil
+		}
+
+		next, err := s.createNextOccurrence(ctx, span, q, todo)
+		if err != nil {
+			return err
+		}
+
+		if next != nil {
+			res.NextOccurrence = newReadResponse(*next)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *server) Reopen(ctx context.Context, req *connect.Request[pb.ReopenRequest]) (*connect.Response[pb.ReopenResponse], error) {
+	ctx, span := tracer.Start(ctx, "Reopen")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	todoID := req.Msg.GetTodoId()
+
+	row, err := s.queries.Reopen(ctx, sqlc.ReopenParams{
 		UserID: userID,
 		TodoID: todoID,
-	}); err != nil {
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
 		instrumentation.TraceError(span, err)
 		return nil, newInternalError(err)
 	}
 
-	return connect.NewResponse(&pb.DeleteResponse{}), nil
+	return connect.NewResponse(&pb.ReopenResponse{
+		Todo: newReadResponse(row),
+	}), nil
+}
+
+func (s *server) MoveToList(ctx context.Context, req *connect.Request[pb.MoveToListRequest]) (*connect.Response[pb.MoveToListResponse], error) {
+	ctx, span := tracer.Start(ctx, "MoveToList")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	row, err := s.queries.MoveToList(ctx, sqlc.MoveToListParams{
+		UserID: userID,
+		TodoID: msg.GetTodoId(),
+		ListID: newText(msg.GetListId()),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		if isForeignKeyViolation(err, listForeignKey) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.MoveToListResponse{
+		Todo: newReadResponse(row),
+	}), nil
+}
+
+// noRowsError explains why a write guarded by expectedVersion matched no rows:
+// either the todo does not exist or it is at a different version.
+func noRowsError(ctx context.Context, span trace.Span, q *sqlc.Queries, userID, todoID string, expectedVersion int64) error {
+	if expectedVersion == 0 {
+		return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+	}
+
+	if _, err := q.Read(ctx, sqlc.ReadParams{
+		UserID: userID,
+		TodoID: todoID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return newInternalError(err)
+	}
+
+	return newPublicError(connect.NewError(connect.CodeAborted, ErrVersionMismatch))
+}
+
+func newReadResponse(row sqlc.TodoappTodo) *pb.ReadResponse {
+	return &pb.ReadResponse{
+		UserId:              row.UserID,
+		TodoId:              row.TodoID,
+		Todo:                row.Todo,
+		CreatedAt:           timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt:           timestamppb.New(row.UpdatedAt.Time),
+		Completed:           row.Completed,
+		CompletedAt:         newTimestamp(row.CompletedAt),
+		DueAt:               newTimestamp(row.DueAt),
+		ListId:              row.ListID.String,
+		Version:             row.Version,
+		DeletedAt:           newTimestamp(row.DeletedAt),
+		ParentTodoId:        row.ParentTodoID.String,
+		ChildCount:          row.ChildCount,
+		CompletedChildCount: row.CompletedChildCount,
+		Position:            row.Position,
+		Rrule:               row.Rrule.String,
+		RemindAt:            newTimestamp(row.RemindAt),
+		Priority:            pb.Priority(row.Priority),
+		AssigneeId:          row.AssigneeID.String,
+	}
+}
+
+// newTimestamp converts a nullable timestamp, returning nil when it is unset.
+func newTimestamp(t pgtype.Timestamptz) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+
+	return timestamppb.New(t.Time)
+}
+
+// newTimestamptz is the inverse of newTimestamp; a nil timestamp is NULL.
+func newTimestamptz(t *timestamppb.Timestamp) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+
+	return pgtype.Timestamptz{Time: t.AsTime(), Valid: true}
+}
+
+// newText converts an optional string, where the empty string is NULL.
+func newText(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{String: s, Valid: true}
+}
+
+// isForeignKeyViolation reports whether err is because the row referenced by
+// the given foreign key, such as a list, does not exist.
+func isForeignKeyViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation && pgErr.ConstraintName == constraint
+}
+
+// isCheckViolation reports whether err is because of the given check
+// constraint, such as a todo becoming its own ancestor.
+func isCheckViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == checkViolation && pgErr.ConstraintName == constraint
 }
 
 type ServerError struct {
@@ -180,6 +783,11 @@ func (e *ServerError) Error() string {
 	return e.Public.Error()
 }
 
+// Unwrap exposes the public error so that connect reports its code.
+func (e *ServerError) Unwrap() error {
+	return e.Public
+}
+
 func newInternalError(err error) *ServerError {
 	return &ServerError{
 		Public:   connect.NewError(connect.CodeInternal, errors.New("internal server error")),
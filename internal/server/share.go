@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrShareWithOwner = errors.New("a list cannot be shared with its owner")
+	ErrNotListOwner   = errors.New("only the owner of a list can share it")
+	ErrViewOnly       = errors.New("the todo is in a list shared with you as a viewer")
+)
+
+// Roles, as stored in todoapp.list_share. ReadTodoRole and ReadListRole
+// return roleOwner for the user's own todos and lists.
+const (
+	roleOwner  = "owner"
+	roleViewer = "viewer"
+	roleEditor = "editor"
+)
+
+var roles = map[pb.Role]string{
+	pb.Role_ROLE_VIEWER: roleViewer,
+	pb.Role_ROLE_EDITOR: roleEditor,
+}
+
+func (s *listServer) ShareList(ctx context.Context, req *connect.Request[pb.ShareListRequest]) (*connect.Response[pb.ShareListResponse], error) {
+	ctx, span := tracer.Start(ctx, "ShareList")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	role, err := s.queries.ReadListRole(ctx, sqlc.ReadListRoleParams{
+		UserID: userID,
+		ListID: msg.GetListId(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	if role.Role != roleOwner {
+		return nil, newPublicError(connect.NewError(connect.CodePermissionDenied, ErrNotListOwner))
+	}
+
+	row, err := s.queries.ShareList(ctx, sqlc.ShareListParams{
+		OwnerID: userID,
+		ListID:  msg.GetListId(),
+		UserID:  msg.GetUserId(),
+		Role:    roles[msg.GetRole()],
+	})
+	if err != nil {
+		if isCheckViolation(err, listShareNotOwnerConstraint) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrShareWithOwner))
+		}
+
+		// The list was deleted since it was read.
+		if isForeignKeyViolation(err, listShareForeignKey) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.ShareListResponse{
+		Collaborator: newCollaborator(row),
+	}), nil
+}
+
+// Unshare succeeds even if the list was not shared with the user, so that it
+// is safe to retry.
+func (s *listServer) Unshare(ctx context.Context, req *connect.Request[pb.UnshareRequest]) (*connect.Response[pb.UnshareResponse], error) {
+	ctx, span := tracer.Start(ctx, "Unshare")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	err := s.queries.Unshare(ctx, sqlc.UnshareParams{
+		ListID:   msg.GetListId(),
+		UserID:   msg.GetUserId(),
+		CallerID: userID,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.UnshareResponse{}), nil
+}
+
+func (s *listServer) ListCollaborators(ctx context.Context, req *connect.Request[pb.ListCollaboratorsRequest]) (*connect.Response[pb.ListCollaboratorsResponse], error) {
+	ctx, span := tracer.Start(ctx, "ListCollaborators")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	listID := req.Msg.GetListId()
+
+	role, err := s.queries.ReadListRole(ctx, sqlc.ReadListRoleParams{
+		UserID: userID,
+		ListID: listID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrListIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	rows, err := s.queries.ReadCollaborators(ctx, sqlc.ReadCollaboratorsParams{
+		OwnerID: role.OwnerID,
+		ListID:  listID,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	collaborators := make([]*pb.Collaborator, 0, len(rows))
+	for _, row := range rows {
+		collaborators = append(collaborators, newCollaborator(row))
+	}
+
+	return connect.NewResponse(&pb.ListCollaboratorsResponse{
+		OwnerId:       role.OwnerID,
+		Collaborators: collaborators,
+	}), nil
+}
+
+// todoOwner returns the id of the user who owns the todo: userID, unless the
+// todo is in a list shared with userID. edit says whether userID must be
+// allowed to change the todo. If userID cannot see the todo at all it returns
+// userID, so that the caller's query reports that the todo does not exist.
+func todoOwner(ctx context.Context, span trace.Span, q *sqlc.Queries, userID, todoID string, edit bool) (string, error) {
+	row, err := q.ReadTodoRole(ctx, sqlc.ReadTodoRoleParams{
+		UserID: userID,
+		TodoID: todoID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return userID, nil
+		}
+
+		instrumentation.TraceError(span, err)
+		return "", newInternalError(err)
+	}
+
+	if edit && row.Role == roleViewer {
+		return "", newPublicError(connect.NewError(connect.CodePermissionDenied, ErrViewOnly))
+	}
+
+	return row.OwnerID, nil
+}
+
+func newCollaborator(row sqlc.TodoappListShare) *pb.Collaborator {
+	role := pb.Role_ROLE_VIEWER
+	if row.Role == roleEditor {
+		role = pb.Role_ROLE_EDITOR
+	}
+
+	return &pb.Collaborator{
+		UserId:    row.UserID,
+		Role:      role,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrInvalidSyncToken = errors.New("invalid sync token")
+
+func (s *server) Sync(ctx context.Context, req *connect.Request[pb.SyncRequest]) (*connect.Response[pb.SyncResponse], error) {
+	ctx, span := tracer.Start(ctx, "Sync")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	// A sync token is the change sequence number of the last change the
+	// client has seen, so it is encoded like a page token.
+	afterSeq, err := decodePageToken(msg.GetSyncToken())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrInvalidSyncToken))
+	}
+
+	size := pageSize(msg.GetPageSize())
+
+	// Both queries must see the same snapshot, or a change committed in
+	// between could be skipped.
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	q := s.queries.WithTx(tx)
+
+	// Fetch one extra row of each so we know whether there are more changes.
+	todos, err := q.ReadTodoChanges(ctx, sqlc.ReadTodoChangesParams{
+		UserID:    userID,
+		ChangeSeq: afterSeq,
+		Limit:     size + 1,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	tombstones, err := q.ReadTombstones(ctx, sqlc.ReadTombstonesParams{
+		UserID:    userID,
+		ChangeSeq: afterSeq,
+		Limit:     size + 1,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	res := &pb.SyncResponse{
+		SyncToken: msg.GetSyncToken(),
+	}
+
+	// Merge the two in sequence order, up to the page size.
+	lastSeq := afterSeq
+	i, j := 0, 0
+	for n := 0; n < int(size) && (i < len(todos) || j < len(tombstones)); n++ {
+		if j == len(tombstones) || (i < len(todos) && todos[i].ChangeSeq < tombstones[j].ChangeSeq) {
+			todo := todos[i]
+			if todo.DeletedAt.Valid {
+				res.DeletedTodoIds = append(res.DeletedTodoIds, todo.TodoID)
+			} else {
+				res.Todos = append(res.Todos, newReadResponse(todo))
+			}
+
+			lastSeq = todo.ChangeSeq
+			i++
+		} else {
+			res.DeletedTodoIds = append(res.DeletedTodoIds, tombstones[j].TodoID)
+			lastSeq = tombstones[j].ChangeSeq
+			j++
+		}
+	}
+
+	if lastSeq != afterSeq {
+		res.SyncToken = encodePageToken(lastSeq)
+	}
+
+	res.HasMore = i < len(todos) || j < len(tombstones)
+
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddTags reads the todo and tags it in one transaction, so that the todo
+// cannot be purged in between.
+func (s *server) AddTags(ctx context.Context, req *connect.Request[pb.AddTagsRequest]) (*connect.Response[pb.AddTagsResponse], error) {
+	ctx, span := tracer.Start(ctx, "AddTags")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	todoID := req.Msg.GetTodoId()
+	names := normalizeTags(req.Msg.GetTags())
+
+	var tags []string
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		if err := readTodoToTag(ctx, span, q, userID, todoID); err != nil {
+			return err
+		}
+
+		if err := q.CreateTags(ctx, sqlc.CreateTagsParams{
+			UserID: userID,
+			Names:  names,
+		}); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		if err := q.AddTags(ctx, sqlc.AddTagsParams{
+			UserID: userID,
+			TodoID: todoID,
+			Names:  names,
+		}); err != nil {
+			// The todo was purged since it was read.
+			if isForeignKeyViolation(err, todoTagForeignKey) {
+				return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		var err error
+		tags, err = q.ReadTodoTags(ctx, sqlc.ReadTodoTagsParams{
+			UserID: userID,
+			TodoID: todoID,
+		})
+		if err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.AddTagsResponse{
+		Tags: tags,
+	}), nil
+}
+
+func (s *server) RemoveTags(ctx context.Context, req *connect.Request[pb.RemoveTagsRequest]) (*connect.Response[pb.RemoveTagsResponse], error) {
+	ctx, span := tracer.Start(ctx, "RemoveTags")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	todoID := req.Msg.GetTodoId()
+	names := normalizeTags(req.Msg.GetTags())
+
+	var tags []string
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		if err := readTodoToTag(ctx, span, q, userID, todoID); err != nil {
+			return err
+		}
+
+		if err := q.RemoveTags(ctx, sqlc.RemoveTagsParams{
+			UserID: userID,
+			TodoID: todoID,
+			Names:  names,
+		}); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		// Tags only exist while some todo has them.
+		if err := q.DeleteUnusedTags(ctx, sqlc.DeleteUnusedTagsParams{
+			UserID: userID,
+			Names:  names,
+		}); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		var err error
+		tags, err = q.ReadTodoTags(ctx, sqlc.ReadTodoTagsParams{
+			UserID: userID,
+			TodoID: todoID,
+		})
+		if err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.RemoveTagsResponse{
+		Tags: tags,
+	}), nil
+}
+
+func (s *server) ListTags(ctx context.Context, req *connect.Request[pb.ListTagsRequest]) (*connect.Response[pb.ListTagsResponse], error) {
+	ctx, span := tracer.Start(ctx, "ListTags")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	rows, err := s.queries.ListTags(ctx, userID)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	tags := make([]*pb.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, &pb.Tag{
+			Name:      row.Name,
+			TodoCount: row.TodoCount,
+		})
+	}
+
+	return connect.NewResponse(&pb.ListTagsResponse{
+		Tags: tags,
+	}), nil
+}
+
+// readTodoToTag checks that the todo exists and is not in the trash.
+func readTodoToTag(ctx context.Context, span trace.Span, q *sqlc.Queries, userID, todoID string) error {
+	if _, err := q.Read(ctx, sqlc.ReadParams{
+		UserID: userID,
+		TodoID: todoID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+		}
+
+		instrumentation.TraceError(span, err)
+		return newInternalError(err)
+	}
+
+	return nil
+}
+
+// normalizeTags lower cases tags so that they are matched case-insensitively.
+func normalizeTags(tags []string) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, strings.ToLower(tag))
+	}
+
+	return names
+}
+
+// newTagFilter returns the tag to filter by, or NULL when there is none.
+func newTagFilter(tag string) pgtype.Text {
+	return newText(strings.ToLower(tag))
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/exp/slog"
+)
+
+var ErrTodoIDNotInTrash = errors.New("todo id is not in the trash")
+
+func (s *server) ListTrash(ctx context.Context, req *connect.Request[pb.ListTrashRequest]) (*connect.Response[pb.ListTrashResponse], error) {
+	ctx, span := tracer.Start(ctx, "ListTrash")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	msg := req.Msg
+
+	afterID, err := decodePageToken(msg.GetPageToken())
+	if err != nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	size := pageSize(msg.GetPageSize())
+
+	// Fetch one extra row so we know whether there is another page.
+	rows, err := s.queries.ReadTrashPage(ctx, sqlc.ReadTrashPageParams{
+		UserID: userID,
+		ID:     afterID,
+		Limit:  size + 1,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	var nextPageToken string
+	if len(rows) > int(size) {
+		rows = rows[:size]
+		nextPageToken = encodePageToken(rows[len(rows)-1].ID)
+	}
+
+	todos := make([]*pb.ReadResponse, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, newReadResponse(row))
+	}
+
+	return connect.NewResponse(&pb.ListTrashResponse{
+		Todos:         todos,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (s *server) Restore(ctx context.Context, req *connect.Request[pb.RestoreRequest]) (*connect.Response[pb.RestoreResponse], error) {
+	ctx, span := tracer.Start(ctx, "Restore")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	row, err := s.queries.Restore(ctx, sqlc.RestoreParams{
+		UserID: userID,
+		TodoID: req.Msg.GetTodoId(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, newPublicError(connect.NewError(connect.CodeNotFound, ErrTodoIDNotInTrash))
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.RestoreResponse{
+		Todo: newReadResponse(row),
+	}), nil
+}
+
+func (s *server) PurgeTrash(ctx context.Context, req *connect.Request[pb.PurgeTrashRequest]) (*connect.Response[pb.PurgeTrashResponse], error) {
+	ctx, span := tracer.Start(ctx, "PurgeTrash")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	n, err := s.queries.PurgeTrash(ctx, userID)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	return connect.NewResponse(&pb.PurgeTrashResponse{
+		Purged: n,
+	}), nil
+}
+
+// RunTrashPurger permanently deletes todos that have been in the trash for
+// longer than retention. It checks every interval until ctx is done.
+func RunTrashPurger(ctx context.Context, pool *pgxpool.Pool, retention, interval time.Duration) {
+	queries := sqlc.New(pool)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := queries.PurgeExpiredTrash(ctx, pgtype.Timestamptz{Time: time.Now().Add(-retention), Valid: true})
+			if err != nil {
+				slog.ErrorCtx(ctx, "error purging trash", "error", err.Error())
+				continue
+			}
+
+			if n > 0 {
+				slog.InfoCtx(ctx, "purged trash", "count", n)
+			}
+		}
+	}
+}
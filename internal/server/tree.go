@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+)
+
+// ReadTree returns a todo with all of its subtasks, their subtasks and so on.
+// Subtasks in the trash are left out, along with their subtasks.
+func (s *server) ReadTree(ctx context.Context, req *connect.Request[pb.ReadTreeRequest]) (*connect.Response[pb.ReadTreeResponse], error) {
+	ctx, span := tracer.Start(ctx, "ReadTree")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+	todoID := req.Msg.GetTodoId()
+
+	rows, err := s.queries.ReadTree(ctx, sqlc.ReadTreeParams{
+		UserID: userID,
+		TodoID: todoID,
+	})
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	root := newTree(todoID, rows)
+	if root == nil {
+		return nil, newPublicError(connect.NewError(connect.CodeInvalidArgument, ErrTodoIDDoesNotExist))
+	}
+
+	return connect.NewResponse(&pb.ReadTreeResponse{
+		Root: root,
+	}), nil
+}
+
+// newTree links rows, which are ordered by id, into a tree under rootID. It
+// returns nil if rootID is not among them.
+func newTree(rootID string, rows []sqlc.TodoappTodo) *pb.TodoNode {
+	nodes := make(map[string]*pb.TodoNode, len(rows))
+	for _, row := range rows {
+		nodes[row.TodoID] = &pb.TodoNode{Todo: newReadResponse(row)}
+	}
+
+	for _, row := range rows {
+		if row.TodoID == rootID {
+			continue
+		}
+
+		if parent, ok := nodes[row.ParentTodoID.String]; ok {
+			parent.Children = append(parent.Children, nodes[row.TodoID])
+		}
+	}
+
+	return nodes[rootID]
+}
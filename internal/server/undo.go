@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/craigpastro/todoapp/internal/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	operationCreate = "create"
+	operationUpdate = "update"
+	operationDelete = "delete"
+
+	// operationLogSize is how many operations are kept for each user, and so
+	// how many can be undone in a row.
+	operationLogSize = 50
+)
+
+var (
+	ErrNothingToUndo = errors.New("nothing to undo")
+
+	// An operation that cannot be undone is dropped from the log, so that
+	// the next Undo moves on to the operation before it.
+	ErrTodoChangedSinceOperation = errors.New("todo has been changed since the operation, so it can no longer be undone")
+)
+
+var operationTypes = map[string]pb.UndoResponse_OperationType{
+	operationCreate: pb.UndoResponse_OPERATION_TYPE_CREATE,
+	operationUpdate: pb.UndoResponse_OPERATION_TYPE_UPDATE,
+	operationDelete: pb.UndoResponse_OPERATION_TYPE_DELETE,
+}
+
+// logOperation records that the todo was just created or deleted.
+func logOperation(ctx context.Context, span trace.Span, q *sqlc.Queries, userID, todoID, kind string) error {
+	return createOperation(ctx, span, q, sqlc.CreateOperationParams{
+		Kind:   kind,
+		UserID: userID,
+		TodoID: todoID,
+	})
+}
+
+// logUpdate records that the todo was just updated, and what it was before,
+// which must be read in the same transaction.
+func logUpdate(ctx context.Context, span trace.Span, q *sqlc.Queries, before sqlc.TodoappTodo) error {
+	return createOperation(ctx, span, q, sqlc.CreateOperationParams{
+		Kind:         operationUpdate,
+		Todo:         pgtype.Text{String: before.Todo, Valid: true},
+		DueAt:        before.DueAt,
+		ParentTodoID: before.ParentTodoID,
+		Rrule:        before.Rrule,
+		RemindAt:     before.RemindAt,
+		Priority:     pgtype.Int2{Int16: before.Priority, Valid: true},
+		UserID:       before.UserID,
+		TodoID:       before.TodoID,
+	})
+}
+
+// createOperation logs the operation and forgets the user's oldest operations
+// beyond operationLogSize.
+func createOperation(ctx context.Context, span trace.Span, q *sqlc.Queries, arg sqlc.CreateOperationParams) error {
+	if err := q.CreateOperation(ctx, arg); err != nil {
+		instrumentation.TraceError(span, err)
+		return newInternalError(err)
+	}
+
+	if err := q.DeleteOldOperations(ctx, sqlc.DeleteOldOperationsParams{
+		UserID: arg.UserID,
+		Offset: operationLogSize,
+	}); err != nil {
+		instrumentation.TraceError(span, err)
+		return newInternalError(err)
+	}
+
+	return nil
+}
+
+// Undo reverses the user's most recent create, update or delete: a created
+// todo is moved to the trash, an updated todo gets back every field that
+// Update can change, and a deleted todo is restored from the trash.
+func (s *server) Undo(ctx context.Context, req *connect.Request[pb.UndoRequest]) (*connect.Response[pb.UndoResponse], error) {
+	ctx, span := tracer.Start(ctx, "Undo")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	var res *pb.UndoResponse
+	err := s.inTx(ctx, span, func(tx pgx.Tx) error {
+		q := s.queries.WithTx(tx)
+
+		op, err := q.ReadLatestOperation(ctx, userID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return newPublicError(connect.NewError(connect.CodeFailedPrecondition, ErrNothingToUndo))
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		if err := q.DeleteOperation(ctx, op.ID); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		res, err = s.undo(ctx, tx, op)
+		if err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		if res == nil || res.Todo == nil {
+			return nil
+		}
+
+		// The todo is now as it was right after the previous operation on it,
+		// but at a new version.
+		if err := q.SetPreviousOperationVersion(ctx, sqlc.SetPreviousOperationVersionParams{
+			Version: res.Todo.GetVersion(),
+			UserID:  userID,
+			TodoID:  op.TodoID,
+		}); err != nil {
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Committed, so the operation is dropped from the log either way.
+	if res == nil {
+		return nil, newPublicError(connect.NewError(connect.CodeAborted, ErrTodoChangedSinceOperation))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// undo reverses op, provided that the todo is still at op's version. It
+// returns nil if it is not.
+func (s *server) undo(ctx context.Context, tx pgx.Tx, op sqlc.TodoappOperation) (*pb.UndoResponse, error) {
+	q := s.queries.WithTx(tx)
+
+	res := &pb.UndoResponse{
+		Type:   operationTypes[op.Kind],
+		TodoId: op.TodoID,
+	}
+
+	var row sqlc.TodoappTodo
+	var err error
+
+	switch op.Kind {
+	case operationCreate:
+		n, err := q.Delete(ctx, sqlc.DeleteParams{
+			UserID:          op.UserID,
+			TodoID:          op.TodoID,
+			ExpectedVersion: op.Version,
+		})
+		if err != nil || n == 0 {
+			return nil, err
+		}
+
+		return res, nil
+	case operationUpdate:
+		row, err = undoUpdate(ctx, tx, op)
+	case operationDelete:
+		row, err = q.Restore(ctx, sqlc.RestoreParams{
+			UserID:          op.UserID,
+			TodoID:          op.TodoID,
+			ExpectedVersion: op.Version,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	res.Todo = newReadResponse(row)
+
+	return res, nil
+}
+
+// undoUpdate puts back the fields that op changed. It returns pgx.ErrNoRows if
+// they cannot be put back, for example because the previous parent has since
+// been purged.
+func undoUpdate(ctx context.Context, tx pgx.Tx, op sqlc.TodoappOperation) (sqlc.TodoappTodo, error) {
+	// A savepoint, so that a failed update does not abort the transaction,
+	// which still has to drop the operation.
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return sqlc.TodoappTodo{}, err
+	}
+	defer func() {
+		_ = sp.Rollback(ctx)
+	}()
+
+	row, err := postgres.UpdateTodo(ctx, sp, postgres.UpdateTodoParams{
+		UserID:          op.UserID,
+		TodoID:          op.TodoID,
+		ExpectedVersion: op.Version,
+		Todo:            &op.Todo.String,
+		DueAt:           &op.DueAt,
+		ParentTodoID:    &op.ParentTodoID,
+		RRule:           &op.Rrule,
+		RemindAt:        &op.RemindAt,
+		Priority:        &op.Priority.Int16,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err, parentForeignKey) || isCheckViolation(err, parentCycleConstraint) {
+			return row, pgx.ErrNoRows
+		}
+
+		return row, err
+	}
+
+	return row, sp.Commit(ctx)
+}
@@ -0,0 +1,343 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	ctxpkg "github.com/craigpastro/todoapp/internal/context"
+	"github.com/craigpastro/todoapp/internal/gen/sqlc"
+	pb "github.com/craigpastro/todoapp/internal/gen/todoapp/v1"
+	"github.com/craigpastro/todoapp/internal/instrumentation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	// todoEventChannel is the channel that the todo_record_event trigger
+	// notifies, with the user id as the payload.
+	todoEventChannel = "todo_event"
+
+	// EventRetention is how long events are kept, and so how long a resume
+	// token remains valid.
+	EventRetention = 7 * 24 * time.Hour
+
+	watchBatchSize = 100
+
+	// watchPollInterval bounds how long a missed notification, for example
+	// while the listener reconnects, can delay events.
+	watchPollInterval = 30 * time.Second
+)
+
+var (
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	ErrResumeTokenExpired = errors.New("resume token has expired; read all todos and watch again")
+)
+
+var eventTypes = map[string]pb.WatchResponse_EventType{
+	"created": pb.WatchResponse_EVENT_TYPE_CREATED,
+	"updated": pb.WatchResponse_EVENT_TYPE_UPDATED,
+	"deleted": pb.WatchResponse_EVENT_TYPE_DELETED,
+}
+
+func (s *server) Watch(ctx context.Context, req *connect.Request[pb.WatchRequest], stream *connect.ServerStream[pb.WatchResponse]) error {
+	ctx, span := tracer.Start(ctx, "Watch")
+	defer span.End()
+
+	userID := ctxpkg.GetUserIDFromCtx(ctx)
+
+	// Subscribe before reading so that no notification is lost in between.
+	notified, unsubscribe := s.broker.subscribe(userID)
+	defer unsubscribe()
+
+	afterSeq, err := s.watchStart(ctx, span, userID, req.Msg.GetResumeToken())
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := s.queries.ReadTodoEvents(ctx, sqlc.ReadTodoEventsParams{
+			UserID: userID,
+			Seq:    afterSeq,
+			Limit:  watchBatchSize,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			instrumentation.TraceError(span, err)
+			return newInternalError(err)
+		}
+
+		for _, event := range events {
+			res, err := s.newWatchResponse(ctx, span, userID, event)
+			if err != nil {
+				return err
+			}
+
+			afterSeq = event.Seq
+
+			if res == nil {
+				continue
+			}
+
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.broker.done:
+			return nil
+		case <-notified:
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchStart returns the seq of the last event the client has seen. Without a
+// resume token, that is the latest event.
+func (s *server) watchStart(ctx context.Context, span trace.Span, userID, token string) (int64, error) {
+	if token != "" {
+		seq, createdAt, err := decodeResumeToken(token)
+		if err != nil {
+			return 0, newPublicError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+
+		if time.Since(createdAt) > EventRetention {
+			return 0, newPublicError(connect.NewError(connect.CodeFailedPrecondition, ErrResumeTokenExpired))
+		}
+
+		return seq, nil
+	}
+
+	seq, err := s.queries.ReadLatestTodoEventSeq(ctx, userID)
+	if err != nil {
+		instrumentation.TraceError(span, err)
+		return 0, newInternalError(err)
+	}
+
+	return seq, nil
+}
+
+// newWatchResponse returns the response for event, or nil if the todo has
+// since been deleted, in which case a later delete event will be sent.
+func (s *server) newWatchResponse(ctx context.Context, span trace.Span, userID string, event sqlc.ReadTodoEventsRow) (*pb.WatchResponse, error) {
+	res := &pb.WatchResponse{
+		Type:        eventTypes[event.Kind],
+		TodoId:      event.TodoID,
+		ResumeToken: encodeResumeToken(event.Seq, event.CreatedAt),
+	}
+
+	if res.Type == pb.WatchResponse_EVENT_TYPE_DELETED {
+		return res, nil
+	}
+
+	row, err := s.queries.Read(ctx, sqlc.ReadParams{
+		UserID: userID,
+		TodoID: event.TodoID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		instrumentation.TraceError(span, err)
+		return nil, newInternalError(err)
+	}
+
+	res.Todo = newReadResponse(row)
+
+	return res, nil
+}
+
+// encodeResumeToken returns an opaque token that points just past the given
+// event. The event's time is included so that expired tokens can be refused
+// rather than silently skipping purged events. The "s" marks the number as a
+// seq, so that tokens from before events had one are refused.
+func encodeResumeToken(seq int64, createdAt pgtype.Timestamptz) string {
+	s := fmt.Sprintf("s:%d:%d", seq, createdAt.Time.UnixMicro())
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// decodeResumeToken is the inverse of encodeResumeToken.
+func decodeResumeToken(token string) (int64, time.Time, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, time.Time{}, ErrInvalidResumeToken
+	}
+
+	parts := strings.Split(string(b), ":")
+	if len(parts) != 3 || parts[0] != "s" {
+		return 0, time.Time{}, ErrInvalidResumeToken
+	}
+
+	seq, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || seq < 0 {
+		return 0, time.Time{}, ErrInvalidResumeToken
+	}
+
+	micros, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, ErrInvalidResumeToken
+	}
+
+	return seq, time.UnixMicro(micros), nil
+}
+
+// StopWatches ends all Watch streams, and any started later, once they have
+// sent the events they have read. http.Server.Shutdown does not wait for
+// streams to finish on their own, so call it when shutting down.
+func (s *server) StopWatches() {
+	s.broker.stop()
+}
+
+// Listen listens for todo events and wakes up the Watch streams of the users
+// they belong to. It reconnects on errors and returns once ctx is done.
+func (s *server) Listen(ctx context.Context) {
+	for {
+		err := s.broker.listen(ctx, s.pool)
+		if ctx.Err() != nil {
+			return
+		}
+
+		slog.ErrorCtx(ctx, "error listening for todo events", "error", err.Error())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// broker fans notifications out to the Watch streams of each user. A single
+// connection listens for all users. done is closed when the streams should
+// end.
+type broker struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func newBroker() *broker {
+	return &broker{
+		subs: map[string]map[chan struct{}]struct{}{},
+		done: make(chan struct{}),
+	}
+}
+
+func (b *broker) stop() {
+	b.stopOnce.Do(func() {
+		close(b.done)
+	})
+}
+
+// subscribe returns a channel that receives a value whenever the user has new
+// events. Notifications are coalesced, so a value means "read the events",
+// not "there is exactly one event". Call unsubscribe when done.
+func (b *broker) subscribe(userID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	b.mu.Lock()
+	if b.subs[userID] == nil {
+		b.subs[userID] = map[chan struct{}]struct{}{}
+	}
+	b.subs[userID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subs[userID], ch)
+		if len(b.subs[userID]) == 0 {
+			delete(b.subs, userID)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (b *broker) publish(userID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs[userID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// listen holds a connection that listens on todoEventChannel until ctx is
+// done or the connection fails.
+func (b *broker) listen(ctx context.Context, pool *pgxpool.Pool) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection is still listening, so it must not go back to the pool.
+	defer conn.Hijack().Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "listen "+todoEventChannel); err != nil {
+		return err
+	}
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		b.publish(n.Payload)
+	}
+}
+
+// RunEventPurger deletes events older than EventRetention every interval
+// until ctx is done.
+func RunEventPurger(ctx context.Context, pool *pgxpool.Pool, interval time.Duration) {
+	queries := sqlc.New(pool)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := queries.DeleteOldTodoEvents(ctx, pgtype.Timestamptz{Time: time.Now().Add(-EventRetention), Valid: true})
+			if err != nil {
+				slog.ErrorCtx(ctx, "error purging todo events", "error", err.Error())
+				continue
+			}
+
+			if n > 0 {
+				slog.InfoCtx(ctx, "purged todo events", "count", n)
+			}
+		}
+	}
+}
@@ -1,27 +1,528 @@
 -- name: Create :one
-insert into todoapp.todo (user_id, todo)
-values ($1, $2)
+insert into todoapp.todo (user_id, todo, due_at, list_id, parent_todo_id, rrule, remind_at, priority)
+values ($1, $2, $3, $4, $5, $6, $7, $8)
 returning *;
 
+-- name: ReadForUpdate :one
+select *
+from todoapp.todo
+where user_id = $1 and todo_id = $2 and deleted_at is null
+for update;
+
 -- name: Read :one
 select *
 from todoapp.todo
-where user_id = $1 and todo_id = $2;
+where user_id = $1 and todo_id = $2 and deleted_at is null;
 
 -- name: ReadPage :many
 select *
 from todoapp.todo
-where user_id = $1
-and id > $2
+where user_id = @user_id
+and deleted_at is null
+and id > @id
+and (sqlc.narg(due_before)::timestamptz is null or due_at < sqlc.narg(due_before))
+and (sqlc.narg(due_after)::timestamptz is null or due_at > sqlc.narg(due_after))
+and (not @overdue::boolean or (due_at < now() and not completed))
+and (not @no_due_date::boolean or due_at is null)
+and (sqlc.narg(tag)::text is null or exists (
+    select 1
+    from todoapp.todo_tag tt
+    where tt.user_id = todo.user_id and tt.todo_id = todo.todo_id and tt.name = sqlc.narg(tag)
+))
+and (sqlc.narg(list_id)::text is null or list_id = sqlc.narg(list_id))
 order by id asc
-limit 100;
+limit sqlc.arg('limit');
+
+-- name: List :many
+select *
+from todoapp.todo
+where user_id = @user_id
+and deleted_at is null
+and (sqlc.narg(completed)::boolean is null or completed = sqlc.narg(completed))
+and (@text_contains::text = '' or strpos(lower(todo), lower(@text_contains)) > 0)
+and (sqlc.narg(created_after)::timestamptz is null or created_at > sqlc.narg(created_after))
+and (sqlc.narg(created_before)::timestamptz is null or created_at < sqlc.narg(created_before))
+and (sqlc.narg(updated_after)::timestamptz is null or updated_at > sqlc.narg(updated_after))
+and (sqlc.narg(updated_before)::timestamptz is null or updated_at < sqlc.narg(updated_before))
+and (sqlc.narg(list_id)::text is null or list_id = sqlc.narg(list_id))
+and (cardinality(@priorities::smallint[]) = 0 or priority = any(@priorities::smallint[]))
+and (
+    not @has_cursor::boolean
+    or (@descending::boolean and (
+        case @sort_by::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end,
+        case when @sort_by::text = 'priority' then priority else 0 end,
+        case when @sort_by::text = 'position' then position else '' end,
+        id) < (@cursor_key::timestamptz, @cursor_priority::smallint, @cursor_position::text, @cursor_id::bigint))
+    or (not @descending::boolean and (
+        case @sort_by::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end,
+        case when @sort_by::text = 'priority' then priority else 0 end,
+        case when @sort_by::text = 'position' then position else '' end,
+        id) > (@cursor_key::timestamptz, @cursor_priority::smallint, @cursor_position::text, @cursor_id::bigint))
+)
+order by
+    case when @descending::boolean then
+        case @sort_by::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end
+    end desc,
+    case when @descending::boolean and @sort_by::text = 'priority' then priority end desc,
+    case when @descending::boolean and @sort_by::text = 'position' then position end desc,
+    case when @descending::boolean then id end desc,
+    case when not @descending::boolean then
+        case @sort_by::text
+            when 'updated_at' then updated_at
+            when 'due_at' then coalesce(due_at, 'infinity'::timestamptz)
+            when 'position' then '-infinity'::timestamptz
+            when 'priority' then '-infinity'::timestamptz
+            else created_at
+        end
+    end asc,
+    case when not @descending::boolean and @sort_by::text = 'priority' then priority end asc,
+    case when not @descending::boolean and @sort_by::text = 'position' then position end asc,
+    case when not @descending::boolean then id end asc
+limit sqlc.arg('limit');
 
--- name: Update :one
+-- name: Search :many
+select sqlc.embed(t), ts_rank(t.search_vector, q)::real as rank, ts_headline('english', t.todo, q)::text as snippet
+from todoapp.todo t, websearch_to_tsquery('english', @query::text) q
+where t.user_id = @user_id and t.deleted_at is null and t.search_vector @@ q
+order by rank desc, t.id asc
+limit sqlc.arg('limit');
+
+-- name: Delete :execrows
+update todoapp.todo
+set deleted_at = now()
+where user_id = @user_id and todo_id = @todo_id and deleted_at is null
+and (@expected_version::bigint = 0 or version = @expected_version);
+
+-- name: Complete :one
 update todoapp.todo
-set todo = $1, updated_at = NOW()
-where user_id  = $2 AND todo_id = $3
+set completed = true, completed_at = coalesce(completed_at, now()), updated_at = now(), rrule = null
+where user_id = $1 and todo_id = $2 and deleted_at is null
 returning *;
 
--- name: Delete :exec
+-- name: Reopen :one
+update todoapp.todo
+set completed = false, completed_at = null, updated_at = now()
+where user_id = $1 and todo_id = $2 and deleted_at is null
+returning *;
+
+-- name: ReadTrashPage :many
+select *
+from todoapp.todo
+where user_id = @user_id
+and deleted_at is not null
+and id > @id
+order by id asc
+limit sqlc.arg('limit');
+
+-- name: Restore :one
+update todoapp.todo
+set deleted_at = null, updated_at = now()
+where user_id = @user_id and todo_id = @todo_id and deleted_at is not null
+and (@expected_version::bigint = 0 or version = @expected_version)
+returning *;
+
+-- name: PurgeTrash :execrows
+delete from todoapp.todo
+where user_id = $1 and deleted_at is not null;
+
+-- name: PurgeExpiredTrash :execrows
 delete from todoapp.todo
-where user_id = $1 and todo_id = $2;
+where deleted_at < $1;
+
+-- name: MoveToList :one
+update todoapp.todo
+set list_id = $1, updated_at = now()
+where user_id = $2 and todo_id = $3 and deleted_at is null
+returning *;
+
+-- name: CreateTags :exec
+insert into todoapp.tag (user_id, name)
+select @user_id::text, unnest(@names::text[])
+on conflict do nothing;
+
+-- name: AddTags :exec
+insert into todoapp.todo_tag (user_id, todo_id, name)
+select @user_id::text, @todo_id::text, unnest(@names::text[])
+on conflict do nothing;
+
+-- name: CopyTags :exec
+insert into todoapp.todo_tag (user_id, todo_id, name)
+select user_id, @to_todo_id::text, name
+from todoapp.todo_tag
+where user_id = @user_id and todo_id = @from_todo_id;
+
+-- name: RemoveTags :exec
+delete from todoapp.todo_tag
+where user_id = @user_id and todo_id = @todo_id and name = any(@names::text[]);
+
+-- name: DeleteUnusedTags :exec
+delete from todoapp.tag t
+where t.user_id = @user_id and t.name = any(@names::text[])
+and not exists (
+    select 1
+    from todoapp.todo_tag tt
+    where tt.user_id = t.user_id and tt.name = t.name
+);
+
+-- name: ReadTodoTags :many
+select name
+from todoapp.todo_tag
+where user_id = $1 and todo_id = $2
+order by name asc;
+
+-- name: ListTags :many
+select t.name, count(td.id) as todo_count
+from todoapp.tag t
+left join todoapp.todo_tag tt on tt.user_id = t.user_id and tt.name = t.name
+left join todoapp.todo td on td.user_id = tt.user_id and td.todo_id = tt.todo_id and td.deleted_at is null
+where t.user_id = $1
+group by t.name
+order by t.name asc;
+
+-- name: CreateList :one
+insert into todoapp.list (user_id, name)
+values ($1, $2)
+returning *;
+
+-- name: ReadList :one
+select *
+from todoapp.list
+where user_id = $1 and list_id = $2;
+
+-- name: ReadAllLists :many
+select l.*
+from todoapp.list l
+where l.user_id = $1
+or exists (
+    select 1
+    from todoapp.list_share s
+    where s.owner_id = l.user_id and s.list_id = l.list_id and s.user_id = $1
+)
+order by l.id asc;
+
+-- name: UpdateList :one
+update todoapp.list
+set name = $1, updated_at = now()
+where user_id = $2 and list_id = $3
+returning *;
+
+-- name: DeleteList :exec
+delete from todoapp.list
+where user_id = $1 and list_id = $2;
+
+-- name: ReadListForUpdate :one
+select *
+from todoapp.list
+where user_id = $1 and list_id = $2
+for update;
+
+-- name: TrashListTodos :many
+update todoapp.todo
+set deleted_at = now(), list_id = null
+where user_id = $1 and list_id = $2 and deleted_at is null
+returning todo_id;
+
+-- name: DetachListTodos :exec
+update todoapp.todo
+set list_id = null
+where user_id = $1 and list_id = $2;
+
+-- name: ReadIdempotencyKey :one
+select response, request_hash
+from todoapp.idempotency_key
+where user_id = $1 and key = $2 and expires_at > now();
+
+-- name: CreateIdempotencyKey :one
+insert into todoapp.idempotency_key as k (user_id, key, response, expires_at, request_hash)
+values ($1, $2, $3, $4, $5)
+on conflict (user_id, key) do update
+set response = excluded.response, created_at = now(), expires_at = excluded.expires_at, request_hash = excluded.request_hash
+where k.expires_at <= now()
+returning k.key;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+delete from todoapp.idempotency_key
+where expires_at <= now();
+
+-- name: ReadTodoEvents :many
+select seq, todo_id, kind, created_at
+from todoapp.todo_event
+where user_id = $1 and seq > $2
+order by seq asc
+limit $3;
+
+-- name: ReadLatestTodoEventSeq :one
+select coalesce(max(seq), 0)::bigint as seq
+from todoapp.todo_event
+where user_id = $1;
+
+-- name: DeleteOldTodoEvents :execrows
+delete from todoapp.todo_event
+where created_at < $1;
+
+-- name: ReadTodoChanges :many
+select *
+from todoapp.todo
+where user_id = $1 and change_seq > $2
+order by change_seq asc
+limit $3;
+
+-- name: ReadTombstones :many
+select *
+from todoapp.todo_tombstone
+where user_id = $1 and change_seq > $2
+order by change_seq asc
+limit $3;
+
+-- name: ReadRevisionPage :many
+select *
+from todoapp.todo_revision
+where user_id = $1 and todo_id = $2 and id > $3
+order by id asc
+limit $4;
+
+-- name: ReadRevision :one
+select *
+from todoapp.todo_revision
+where user_id = $1 and todo_id = $2 and version = $3;
+
+-- name: CreateOperation :exec
+insert into todoapp.operation (user_id, todo_id, kind, version, todo, due_at, parent_todo_id, rrule, remind_at, priority)
+select user_id, todo_id, @kind::text, version, @todo::text, @due_at::timestamptz, @parent_todo_id::text, @rrule::text, @remind_at::timestamptz, @priority::smallint
+from todoapp.todo
+where user_id = @user_id and todo_id = @todo_id;
+
+-- name: DeleteOldOperations :exec
+delete from todoapp.operation
+where user_id = $1 and id <= (
+    select id
+    from todoapp.operation
+    where user_id = $1
+    order by id desc
+    offset $2
+    limit 1
+);
+
+-- name: ReadLatestOperation :one
+select *
+from todoapp.operation
+where user_id = $1
+order by id desc
+limit 1
+for update;
+
+-- name: DeleteOperation :exec
+delete from todoapp.operation
+where id = $1;
+
+-- name: SetPreviousOperationVersion :exec
+update todoapp.operation
+set version = @version
+where id = (
+    select max(id)
+    from todoapp.operation
+    where user_id = @user_id and todo_id = @todo_id
+);
+
+-- name: ReadTree :many
+with recursive tree (todo_id) as (
+    select todo_id
+    from todoapp.todo
+    where user_id = $1 and todo_id = $2 and deleted_at is null
+    union
+    select t.todo_id
+    from todoapp.todo t
+    join tree on t.parent_todo_id = tree.todo_id
+    where t.user_id = $1 and t.deleted_at is null
+)
+select *
+from todoapp.todo
+where user_id = $1 and todo_id in (select todo_id from tree)
+order by id asc;
+
+-- name: LockUserTodos :exec
+select seq
+from todoapp.change_seq
+where user_id = $1
+for update;
+
+-- name: ReadPositionBefore :one
+select position
+from todoapp.todo
+where user_id = @user_id
+and list_id is not distinct from @list_id
+and deleted_at is null
+and todo_id <> @todo_id
+and (position, id) < (@position::text, @id::bigint)
+order by position desc, id desc
+limit 1;
+
+-- name: ReadPositionAfter :one
+select position
+from todoapp.todo
+where user_id = @user_id
+and list_id is not distinct from @list_id
+and deleted_at is null
+and todo_id <> @todo_id
+and (position, id) > (@position::text, @id::bigint)
+order by position asc, id asc
+limit 1;
+
+-- name: SetPosition :one
+update todoapp.todo
+set position = $3, updated_at = now()
+where user_id = $1 and todo_id = $2 and deleted_at is null
+returning *;
+
+-- name: RenumberPositions :exec
+select todoapp.renumber_positions(@user_id, @list_id);
+
+-- name: ReadPositionByID :one
+select position
+from todoapp.todo
+where user_id = $1 and id = $2;
+
+-- name: ClaimDueReminders :many
+with due as (
+    select r.user_id, r.todo_id
+    from todoapp.reminder r
+    join todoapp.todo t on t.user_id = r.user_id and t.todo_id = r.todo_id
+    where r.sent_at is null and r.remind_at <= now()
+    and (r.claimed_at is null or r.claimed_at < @claim_expired_before::timestamptz)
+    and t.deleted_at is null and not t.completed
+    order by r.remind_at asc
+    limit @limit
+    for update of r skip locked
+)
+update todoapp.reminder r
+set claimed_at = now()
+from due, todoapp.todo t
+where r.user_id = due.user_id and r.todo_id = due.todo_id
+and t.user_id = r.user_id and t.todo_id = r.todo_id
+returning r.user_id, r.todo_id, r.remind_at, r.claimed_at, t.todo, t.due_at;
+
+-- name: MarkReminderSent :exec
+update todoapp.reminder
+set sent_at = now(), claimed_at = null
+where user_id = $1 and todo_id = $2 and claimed_at = $3;
+
+-- name: ReleaseReminder :exec
+update todoapp.reminder
+set claimed_at = null
+where user_id = $1 and todo_id = $2 and claimed_at = $3;
+
+-- name: ShareList :one
+insert into todoapp.list_share (owner_id, list_id, user_id, role)
+values ($1, $2, $3, $4)
+on conflict (owner_id, list_id, user_id) do update
+set role = excluded.role
+returning *;
+
+-- name: Unshare :exec
+delete from todoapp.list_share
+where list_id = @list_id and user_id = @user_id
+and (owner_id = @caller_id or user_id = @caller_id);
+
+-- name: ReadCollaborators :many
+select *
+from todoapp.list_share
+where owner_id = $1 and list_id = $2
+order by created_at asc, user_id asc;
+
+-- name: ReadListRole :one
+select user_id as owner_id, 'owner'::text as role
+from todoapp.list
+where user_id = @user_id and list_id = @list_id
+union all
+select owner_id, role
+from todoapp.list_share
+where user_id = @user_id and list_id = @list_id
+limit 1;
+
+-- name: ReadTodoRole :one
+select t.user_id as owner_id, coalesce(s.role, 'owner')::text as role
+from todoapp.todo t
+left join todoapp.list_share s on s.owner_id = t.user_id and s.list_id = t.list_id and s.user_id = @user_id
+where t.todo_id = @todo_id and t.deleted_at is null
+and (t.user_id = @user_id or s.user_id is not null)
+order by t.user_id = @user_id desc
+limit 1;
+
+-- name: CreateAssignment :one
+insert into todoapp.assignment (user_id, todo_id, assignee_id, assigned_by)
+values ($1, $2, $3, $4)
+returning *;
+
+-- name: ReadAssignedTodos :many
+select sqlc.embed(t), a.assigned_by, a.created_at as assigned_at
+from todoapp.todo t
+join lateral (
+    select assigned_by, created_at
+    from todoapp.assignment
+    where user_id = t.user_id and todo_id = t.todo_id
+    order by id desc
+    limit 1
+) a on true
+where t.assignee_id = @user_id
+and t.deleted_at is null
+and t.id > @id
+and (sqlc.narg(completed)::boolean is null or t.completed = sqlc.narg(completed))
+and (t.user_id = @user_id or exists (
+    select 1
+    from todoapp.list_share s
+    where s.owner_id = t.user_id and s.list_id = t.list_id and s.user_id = @user_id
+))
+order by t.id asc
+limit sqlc.arg('limit');
+
+-- name: ReadAssignmentPage :many
+select *
+from todoapp.assignment
+where user_id = @user_id and todo_id = @todo_id and id > @id
+order by id asc
+limit sqlc.arg('limit');
+
+-- name: AddComment :one
+insert into todoapp.comment (owner_id, todo_id, user_id, body)
+values ($1, $2, $3, $4)
+returning *;
+
+-- name: ReadComment :one
+select *
+from todoapp.comment
+where comment_id = $1;
+
+-- name: ReadCommentPage :many
+select *
+from todoapp.comment
+where owner_id = @owner_id and todo_id = @todo_id and id > @id
+order by id asc
+limit sqlc.arg('limit');
+
+-- name: EditComment :one
+update todoapp.comment
+set body = $2, updated_at = now()
+where comment_id = $1
+returning *;
+
+-- name: DeleteComment :exec
+delete from todoapp.comment
+where comment_id = $1;